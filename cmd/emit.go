@@ -16,11 +16,32 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+
 	"github.com/margic/goiracing/iracing"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
-var varName string // going to ignore this for now and return a hard coded suspension object
+var emitSinks []string
+var emitVars []string
+var emitRate float64
+var emitUnits string
+var emitControl string
+var emitHTTP string
+var emitGRPC string
+var emitRecordDir string
+
+// sinkFlagOptions is the sink option set by the value of a --sink type=value flag
+var sinkFlagOptions = map[string]string{
+	"file": "path",
+	"mqtt": "broker",
+	"nats": "url",
+}
 
 // emitCmd represents the emit command
 var emitCmd = &cobra.Command{
@@ -28,15 +49,42 @@ var emitCmd = &cobra.Command{
 	Short: "Emits iRacing Telemetry Data",
 	Long: `Emitter for iRacing telemetry. Sets up goiracing to Output options
 		can be modified with flags see goiracing emit --help for details
-		The intention of emit is to enalbe goiracing to continually read `,
-	Run: func(cmd *cobra.Command, args []string) {
-		cfg := &iracing.ClientConfig{
-			Debug:         debug,
-			RetryInterval: 5,
+		The intention of emit is to enalbe goiracing to continually read
+		telemetry and write it to sinks. Choose sinks with --sink e.g.
+		--sink stdout --sink file=telemetry.jsonl --sink nats=nats://localhost:4222
+		--sink mqtt=tcp://localhost:1883
+		or configure them with options under the sinks key of the config file.
+		Serve nats request/reply control endpoints with --control nats://localhost:4222
+		or the control key of the config file, recordings they start are written
+		under --record-dir. Serve the http api of goiracing serve
+		alongside the sinks with --http :8080 and its grpc service with --grpc :9090.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// stop on interrupt
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		cfg := ClientConfig()
+		sinks, err := sinkConfigs(cmd)
+		if err != nil {
+			return err
 		}
+		cfg.Sinks = sinks
 
 		client := iracing.NewClient(cfg)
-		client.Emit(varName)
+		control, err := natsControl(cmd, client)
+		if err != nil {
+			return err
+		}
+		if control != nil {
+			if err := control.Start(); err != nil {
+				return fmt.Errorf("starting nats control: %w", err)
+			}
+			defer control.Close()
+		}
+		if emitHTTP != "" || emitGRPC != "" {
+			return serve(ctx, client, emitHTTP, emitGRPC, &iracing.ServerConfig{})
+		}
+		return client.Run(ctx)
 	},
 }
 
@@ -51,5 +99,60 @@ func init() {
 
 	// Cobra supports local flags which will only run when this command
 	// is called directly, e.g.:
-	emitCmd.Flags().StringVarP(&varName, "variable", "v", "", "iRacing variable name e.g. RPM")
+	emitCmd.Flags().StringArrayVar(&emitSinks, "sink", []string{"nats"}, fmt.Sprintf("sink to emit to as type or type=value, types %v", iracing.SinkTypes()))
+	emitCmd.Flags().StringSliceVarP(&emitVars, "variable", "v", nil, "iRacing variable names to emit e.g. RPM,Speed, all variables by default")
+	emitCmd.Flags().Float64Var(&emitRate, "rate", 0, "maximum frames per second, every tick by default")
+	emitCmd.Flags().StringVar(&emitUnits, "units", "", "convert values to metric or imperial display units")
+	emitCmd.Flags().StringVar(&emitHTTP, "http", "", "address to serve the http api on e.g. :8080")
+	emitCmd.Flags().StringVar(&emitGRPC, "grpc", "", "address to serve the grpc Telemetry service on e.g. :9090")
+	emitCmd.Flags().StringVar(&emitControl, "control", "", "nats url to serve control requests on e.g. nats://localhost:4222")
+	emitCmd.Flags().StringVar(&emitRecordDir, "record-dir", ".", "directory recordings started by control requests are written to")
+}
+
+// sinkConfigs returns the sinks of the --sink flags, or of the sinks key of the config file
+// when no --sink flag is given
+func sinkConfigs(cmd *cobra.Command) ([]iracing.SinkConfig, error) {
+	if !cmd.Flags().Changed("sink") && viper.IsSet("sinks") {
+		var sinks []iracing.SinkConfig
+		if err := viper.UnmarshalKey("sinks", &sinks); err != nil {
+			return nil, fmt.Errorf("reading sinks config: %w", err)
+		}
+		return sinks, nil
+	}
+
+	sinks := make([]iracing.SinkConfig, 0, len(emitSinks))
+	for _, s := range emitSinks {
+		sink := iracing.SinkConfig{Vars: emitVars, Rate: emitRate, Units: emitUnits}
+		sink.Type = s
+		if i := strings.IndexByte(s, '='); i >= 0 {
+			sink.Type = s[:i]
+			option, ok := sinkFlagOptions[sink.Type]
+			if !ok {
+				return nil, fmt.Errorf("sink %s does not take a value", sink.Type)
+			}
+			sink.Options = map[string]interface{}{option: s[i+1:]}
+		}
+		sinks = append(sinks, sink)
+	}
+	return sinks, nil
+}
+
+// natsControl returns the control endpoints of the --control flag, or of the control key of the config file
+// when no --control flag is given. Returns nil if neither is set.
+func natsControl(cmd *cobra.Command, client *iracing.Client) (*iracing.NATSControl, error) {
+	var opts iracing.NATSControlOptions
+	switch {
+	case cmd.Flags().Changed("control"):
+		opts.URL = emitControl
+	case viper.IsSet("control"):
+		if err := viper.UnmarshalKey("control", &opts); err != nil {
+			return nil, fmt.Errorf("reading control config: %w", err)
+		}
+	default:
+		return nil, nil
+	}
+	if opts.RecordDir == "" || cmd.Flags().Changed("record-dir") {
+		opts.RecordDir = emitRecordDir
+	}
+	return iracing.NewNATSControl(client, &opts), nil
 }
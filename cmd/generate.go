@@ -0,0 +1,79 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+
+	"github.com/margic/goiracing/iracing"
+	"github.com/spf13/cobra"
+)
+
+var generateIBT string
+var generateOutput string
+var generateConfig iracing.GenerateConfig
+
+// generateCmd represents the generate command
+var generateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "Generates a Go struct of iRacing telemetry variables",
+	Long: `Generates a Go file with a struct of the telemetry variables of the running
+		sim, or of an ibt file with --ibt, for use with Decode. The file has a constant
+		for each variable name and the variable descriptions and units as comments.
+		Generate only the variables you need with the --variable flag.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var vars []iracing.VarInfo
+		if generateIBT != "" {
+			ibt, err := iracing.OpenIBT(generateIBT)
+			if err != nil {
+				return err
+			}
+			defer ibt.Close()
+			vars = ibt.Vars()
+			generateConfig.Source = generateIBT
+		} else {
+			client := iracing.NewClient(ClientConfig())
+			var err error
+			if vars, err = client.ReadVars(); err != nil {
+				return err
+			}
+			generateConfig.Source = "the running sim"
+		}
+
+		// generate into a buffer so a failure does not leave a partial output file
+		var buf bytes.Buffer
+		if err := iracing.Generate(&buf, vars, &generateConfig); err != nil {
+			return err
+		}
+		if generateOutput == "" {
+			_, err := os.Stdout.Write(buf.Bytes())
+			return err
+		}
+		return ioutil.WriteFile(generateOutput, buf.Bytes(), 0644)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(generateCmd)
+
+	generateCmd.Flags().StringVar(&generateIBT, "ibt", "", "ibt file to read the variables from instead of the running sim")
+	generateCmd.Flags().StringVarP(&generateOutput, "output", "o", "", "path of the go file to write, stdout by default")
+	generateCmd.Flags().StringVar(&generateConfig.Package, "package", "telemetry", "package of the generated file")
+	generateCmd.Flags().StringVar(&generateConfig.Type, "type", "Telemetry", "name of the generated struct")
+	generateCmd.Flags().StringSliceVarP(&generateConfig.Vars, "variable", "v", nil, "iRacing variable names to generate e.g. RPM,Speed")
+}
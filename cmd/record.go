@@ -0,0 +1,54 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+
+	"github.com/margic/goiracing/iracing"
+	"github.com/spf13/cobra"
+)
+
+var recordPath string
+var recordVars []string
+
+// recordCmd represents the record command
+var recordCmd = &cobra.Command{
+	Use:   "record",
+	Short: "Records iRacing Telemetry to an ibt file",
+	Long: `Records live iRacing telemetry to an ibt file that can be opened by standard
+		telemetry tools. Record only the variables you need with the --variable flag,
+		all variables are recorded by default. Recording stops on interrupt.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// stop on interrupt
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		cfg := ClientConfig()
+
+		client := iracing.NewClient(cfg)
+		return client.Record(ctx, recordPath, recordVars)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(recordCmd)
+
+	recordCmd.Flags().StringVarP(&recordPath, "output", "o", "goiracing.ibt", "path of the ibt file to write")
+	recordCmd.Flags().StringSliceVarP(&recordVars, "variable", "v", nil, "iRacing variable names to record e.g. RPM,Speed")
+}
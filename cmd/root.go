@@ -18,6 +18,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/margic/goiracing/iracing"
 	"github.com/spf13/cobra"
@@ -27,6 +28,8 @@ import (
 
 var cfgFile string
 var debug bool
+var retryInitial time.Duration
+var retryMax time.Duration
 
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
@@ -53,6 +56,8 @@ func init() {
 
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is ./goiracing.yaml)")
 	rootCmd.PersistentFlags().BoolVar(&debug, "debug", false, "enable debug output")
+	rootCmd.PersistentFlags().DurationVar(&retryInitial, "retry-initial", iracing.DefaultBackoff.Initial, "initial delay between attempts to connect to iRacing")
+	rootCmd.PersistentFlags().DurationVar(&retryMax, "retry-max", iracing.DefaultBackoff.Max, "maximum delay between attempts to connect to iRacing")
 	// Cobra also supports local flags, which will only run
 	// when this action is called directly.
 	// rootCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
@@ -81,5 +86,10 @@ func initConfig() {
 func ClientConfig() *iracing.ClientConfig {
 	return &iracing.ClientConfig{
 		Debug: debug,
+		Backoff: &iracing.Backoff{
+			Initial:    retryInitial,
+			Max:        retryMax,
+			Multiplier: iracing.DefaultBackoff.Multiplier,
+		},
 	}
 }
@@ -0,0 +1,125 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/margic/goiracing/iracing"
+	"github.com/margic/goiracing/iracingpb"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+// serveShutdownTimeout is how long open connections get to finish when serving stops
+const serveShutdownTimeout = 5 * time.Second
+
+var serveAddr string
+var serveOrigins []string
+var serveGRPC string
+
+// serveCmd represents the serve command
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serves iRacing telemetry over http",
+	Long: `Serves iRacing telemetry to browsers and other http clients.
+		Websocket clients connect to /ws and send a subscription message e.g.
+		{"Type":"subscribe","Vars":["Speed","RPM"],"Rate":30} to receive json
+		frames, session info and session events.
+		GET /session, /variables, /vars/{name}, /vars?names=a,b and /status
+		return the session info, variable catalog, latest values and status.
+		GET /events?names=Speed,RPM&rate=10 streams server-sent frame, session,
+		event, flag and lap events.
+		Serve the grpc Telemetry service of iracingpb with --grpc :9090.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// stop on interrupt
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		client := iracing.NewClient(ClientConfig())
+		return serve(ctx, client, serveAddr, serveGRPC, &iracing.ServerConfig{Origins: serveOrigins})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+	serveCmd.Flags().StringVar(&serveAddr, "addr", ":8080", "address to listen on")
+	serveCmd.Flags().StringSliceVar(&serveOrigins, "origin", nil, "origins allowed to open websockets, any origin by default")
+	serveCmd.Flags().StringVar(&serveGRPC, "grpc", "", "address to serve the grpc Telemetry service on e.g. :9090")
+}
+
+// serve runs the client serving its telemetry over http on httpAddr and grpc on grpcAddr until ctx
+// is cancelled, an empty address is not served
+func serve(ctx context.Context, client *iracing.Client, httpAddr, grpcAddr string, cfg *iracing.ServerConfig) error {
+	serveErr := make(chan error, 2)
+	var srv *http.Server
+	if httpAddr != "" {
+		srv = &http.Server{Addr: httpAddr, Handler: iracing.NewServer(client, cfg)}
+		go func() {
+			if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				serveErr <- fmt.Errorf("serving http: %w", err)
+			}
+		}()
+	}
+	var grpcSrv *grpc.Server
+	if grpcAddr != "" {
+		ln, err := net.Listen("tcp", grpcAddr)
+		if err != nil {
+			return fmt.Errorf("serving grpc: %w", err)
+		}
+		grpcSrv = grpc.NewServer()
+		iracingpb.RegisterTelemetryServer(grpcSrv, iracing.NewTelemetryService(client))
+		go func() {
+			if err := grpcSrv.Serve(ln); err != nil {
+				serveErr <- fmt.Errorf("serving grpc: %w", err)
+			}
+		}()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- client.Run(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-serveErr:
+		cancel()
+		<-runErr
+	case err = <-runErr:
+	}
+	if grpcSrv != nil {
+		// streams end once Run stops so a graceful stop does not wait on them
+		grpcSrv.GracefulStop()
+	}
+	if srv != nil {
+		shutdownCtx, done := context.WithTimeout(context.Background(), serveShutdownTimeout)
+		defer done()
+		if serr := srv.Shutdown(shutdownCtx); err == nil && serr != nil {
+			err = serr
+		}
+	}
+	return err
+}
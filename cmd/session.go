@@ -16,24 +16,44 @@ limitations under the License.
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/margic/goiracing/iracing"
 	"github.com/spf13/cobra"
 )
 
+var sessionQuery string
+
 // sessionCmd represents the session command
 var sessionCmd = &cobra.Command{
 	Use:   "session",
 	Short: "Ouput iRacing Session Information",
 	Long: `Output iRacing Session Infomraiton. Dumps the yaml formatted string of
-		session information from iRacing. Use flags to direct output as required.`,
-	Run: func(cmd *cobra.Command, args []string) {
+		session information from iRacing. Use flags to direct output as required.
+		Output a single value with an irsdk session path using --query e.g.
+		--query "DriverInfo:Drivers:CarIdx:{3}UserName:"`,
+	RunE: func(cmd *cobra.Command, args []string) error {
 		client := iracing.NewClient(ClientConfig())
-		client.Session()
+		if sessionQuery == "" {
+			client.Session()
+			return nil
+		}
+		if err := client.ReadSession(); err != nil {
+			return err
+		}
+		v, err := client.QuerySession(sessionQuery)
+		if err != nil {
+			return err
+		}
+		fmt.Println(v)
+		return nil
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(sessionCmd)
+
+	sessionCmd.Flags().StringVarP(&sessionQuery, "query", "q", "", "irsdk session info path of a single value to output e.g. WeekendInfo:TrackName:")
 	// Here you will define your flags and configuration settings.
 
 	// Cobra supports Persistent Flags which will work for this command
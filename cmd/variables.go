@@ -31,10 +31,8 @@ Cobra is a CLI library for Go that empowers applications.
 This application is a tool to generate the needed files
 to quickly create a Cobra application.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		cfg := &iracing.ClientConfig{
-			Debug:         true,
-			RetryInterval: 5,
-		}
+		cfg := ClientConfig()
+		cfg.Debug = true
 		client := iracing.NewClient(cfg)
 		client.Variables()
 	},
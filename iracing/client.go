@@ -1,21 +1,25 @@
 package iracing
 
 import (
+	"context"
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
-	"math"
-	"os"
-	"os/signal"
 	"sync"
 	"time"
-	"unsafe"
 
 	"go.uber.org/zap"
-	"golang.org/x/sys/windows"
 )
 
-const iracingMemoryMappedFileName string = "Local\\IRSDKMemMapFileName"
+// snapshotRetries is the number of times readVarBuf copies a buffer the sim rewrites during the copy
+const snapshotRetries = 3
+
+// errSnapshotTorn is returned by readVarBuf when the sim rewrote the buffer during every copy
+var errSnapshotTorn = fmt.Errorf("variable buffer rewritten during %d copies", snapshotRetries)
+
+// copyVarBuf copies a variable buffer into a snapshot, tests replace it to rewrite the buffer mid copy
+var copyVarBuf = func(dst, src []byte) { copy(dst, src) }
+
 const (
 	closed = iota
 	open
@@ -25,110 +29,168 @@ const (
 )
 
 type Client struct {
-	logger         *zap.Logger
-	irMemMapedFile uintptr // unsafe pointer to iracing mem mapped file
-	// headerSlice Mmap    // a slice that represents the ir header in the mem mapped file
+	logger               *zap.Logger
+	source               Source // provides the iracing memory area
+	mem                  []byte // the iracing memory area read from source
 	header               *IRHeader
-	varHeaders           map[string]*varHeader // I think this may change frequently depends on if offsets are static consider a lock
+	varHeaders           map[string]*varHeader // re-read by WaitForData when the variable headers change
+	varHeaderBytes       []byte                // copy of the variable headers varHeaders was parsed from
 	SessionInfoYaml      string
 	stop                 bool
-	retryInterval        int
+	backoff              Backoff
+	attempts             int       // failed attempts to attach to the sim since last attached
+	nextAttempt          time.Time // time of the next attempt to attach to the sim
+	staleTimeout         time.Duration
+	lastData             time.Time // time new data was last read
+	state                ConnectionState
+	stateLock            sync.Mutex
+	stateChanges         chan StateChange
 	sessionInfoTickCount int
 	varBufTickCount      int
+	varBufTickRate       int       // tick rate of the header the variable buffer was read with
+	varBufTime           time.Time // time the variable buffer was read
+	varHeadersChanged    bool      // variable headers were re-read since the last DataUpdate
+	updateSessionTick    int       // session info tick count at the last DataUpdate
 	varBuf               []byte
 	varBufLock           sync.Mutex
 	status               int
+	subscriptions        []*Subscription
+	subLock              sync.Mutex
+	sessionYaml          string      // SessionInfoYaml guarded by sessionLock for other goroutines
+	session              *Session    // parsed SessionInfoYaml
+	sessionTree          sessionTree // SessionInfoYaml parsed for path queries
+	sessionEvents        chan SessionEvent
+	pendingEvents        []SessionEvent // session events for the next DataUpdate
+	eventLock            sync.Mutex     // guards pendingEvents as markers are added from other goroutines
+	sinks                []SinkConfig
+	sinkErrors           chan SinkError
+	output               *Output // sinks while Run is running
+	outputLock           sync.Mutex
+	sessionNum           int // SessionNum variable of the last sample read
+	sessionNumRead       bool
+	sessionLock          sync.Mutex
+	recordings           []*recording // recordings written by Run
+	recordLock           sync.Mutex
 }
 
 type ClientConfig struct {
-	Debug         bool
+	Debug bool
+	// RetryInterval is a fixed number of seconds between attempts to attach to the sim.
+	// Deprecated: use Backoff
 	RetryInterval int
+	Backoff       *Backoff      // delay between attempts to attach to the sim, defaults to DefaultBackoff
+	StaleTimeout  time.Duration // time without new data before a connection is stale, defaults to 2s
+	Source        Source        // defaults to the live iracing memory mapped file
+	Sinks         []SinkConfig  // sinks Run writes frames, session info and session events to
 }
 
-func (ir *Client) Emit(varName string) {
-	// TODO change how we open the file so it's retry is within scope of graceful shutdown
-	err := ir.open()
-	if err != nil {
-		ir.logger.Error("error opening client", zap.Error(err))
-		return
-	}
+// Run reads telemetry from the sim and publishes it to subscriptions and the configured sinks until ctx
+// is cancelled. The sim may start, exit and restart while Run is running. When ctx is cancelled
+// Run stops reading, ends subscriptions, flushes and closes the sinks and returns, other errors stop Run
+// and are returned. Sink errors do not stop Run, they are reported on SinkErrors.
+func (ir *Client) Run(ctx context.Context) (err error) {
+	defer ir.close()
+	defer ir.unsubscribeAll()
+	defer ir.closeRecordings()
 
-	// pre read header
-	err = ir.readHeader()
+	o, err := newOutput(ctx, ir, ir.sinks)
 	if err != nil {
-		ir.logger.Error("error reading iracing header", zap.Error(err))
+		return err
 	}
+	ir.outputLock.Lock()
+	ir.output = o
+	ir.outputLock.Unlock()
+	defer func() {
+		ir.outputLock.Lock()
+		ir.output = nil
+		ir.outputLock.Unlock()
+		if cerr := o.Close(); err == nil {
+			err = cerr
+		}
+	}()
 
-	// pre read var headers
-	err = ir.readVarHeaders()
-	if err != nil {
-		ir.logger.Error("error reading variable headers", zap.Error(err))
+	for {
+		update, err := ir.nextData(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		if update.SessionInfoChanged {
+			o.writeSessionInfo(ir.SessionInfoYaml)
+			ir.publishSession(SessionMessage{SessionInfo: ir.SessionInfoYaml})
+		}
+		for i := range update.SessionEvents {
+			o.writeSessionEvent(&update.SessionEvents[i])
+			ir.publishSession(SessionMessage{Event: &update.SessionEvents[i]})
+		}
+		sample := ir.Sample()
+		ir.record(sample)
+		ir.publish(ctx, update.TickCount, ir.header.TickRate, sample)
 	}
+}
 
-	// Start background tasks to read variables bufs and keep them refreshed
-	// also start variable read background tasks to read
+// Record writes the live telemetry variables named in varNames to the ibt file at path
+// until ctx is cancelled. All variables are recorded if varNames is empty.
+func (ir *Client) Record(ctx context.Context, path string, varNames []string) (err error) {
+	defer ir.close()
 
-	// setup signal channels to run reads in background
-	sigReadVarBuf := make(chan bool, 1)
+	// the first sample provides the variable headers for the file
+	if _, err := ir.nextData(ctx); err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return err
+	}
 
-	// start ticker to invoke variable reads
-	ticker := time.NewTicker(20 * time.Millisecond)
-	go func() {
-		for {
-			t := <-ticker.C
-			ir.logger.Debug("TEMP debug. Ticker ticked read varBufs", zap.Time("tick", t))
-			err := ir.readVarBuf()
-			if err != nil {
-				ir.logger.Error("error reading variable buffer", zap.Error(err))
-			}
-			sigReadVarBuf <- true
+	w, err := CreateIBT(path, ir.Sample(), &IBTWriterConfig{
+		TickRate:        ir.header.TickRate,
+		SessionInfoYaml: ir.SessionInfoYaml,
+		Vars:            varNames,
+	})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := w.Close(); err == nil {
+			err = cerr
 		}
 	}()
 
-	go func() {
-		// setup output
-		o := &Output{}
-		out := o.OutputChannel()
-		for {
-			<-sigReadVarBuf
-			s := &Suspension{
-				LFShockDef: ir.readFloat32Var("LFshockDef"),
-				LFShockVel: ir.readFloat32Var("LFshockVel"),
-				RFShockDef: ir.readFloat32Var("RFshockDef"),
-				RFShockVel: ir.readFloat32Var("RFshockVel"),
-				LRShockDef: ir.readFloat32Var("LRshockDef"),
-				LRShockVel: ir.readFloat32Var("LRshockVel"),
-				RRShockDef: ir.readFloat32Var("RRshockDef"),
-				RRShockVel: ir.readFloat32Var("RRshockVel"),
+	for {
+		if err := w.WriteSample(ir.Sample()); err != nil {
+			return err
+		}
+		update, err := ir.nextData(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
 			}
-			out <- s
+			return err
 		}
-	}()
-
-	// graceful shutdown figure this out once it works
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, os.Interrupt)
-	<-quit
-	ticker.Stop()
-
-	time.Sleep(100 * time.Millisecond)
-
-	ir.close()
+		if update.VarHeadersChanged {
+			ir.logger.Warn("variable headers changed while recording")
+		}
+	}
 }
 
 func (ir *Client) Session() {
-	err := ir.open()
-	if err != nil {
-		ir.logger.Error("error opening client", zap.Error(err))
+	if err := ir.ReadSession(); err != nil {
+		ir.logger.Error("error reading session info", zap.Error(err))
 		return
 	}
-	defer ir.close()
+	fmt.Print(ir.SessionInfoYaml)
+}
 
-	err = ir.readHeader()
-	if err != nil {
-		ir.logger.Error("error reading iracing header", zap.Error(err))
+// ReadSession connects to the sim once to read the current session info for SessionInfoYaml,
+// SessionInfo and QuerySession
+func (ir *Client) ReadSession() error {
+	if err := ir.open(); err != nil {
+		return fmt.Errorf("opening client: %w", err)
 	}
-	fmt.Printf(ir.SessionInfoYaml)
+	defer ir.close()
+	return ir.readHeader()
 }
 
 func (ir *Client) Variables() {
@@ -168,94 +230,72 @@ func NewClient(cfg *ClientConfig) *Client {
 	logger := newLogger(cfg.Debug)
 	c := &Client{
 		logger: logger,
+		source: cfg.Source,
 	}
-	if cfg.RetryInterval > 0 {
-		c.retryInterval = cfg.RetryInterval
-	} else {
-		c.retryInterval = 10
+	if c.source == nil {
+		c.source = NewLiveSource()
+	}
+	switch {
+	case cfg.Backoff != nil:
+		c.backoff = *cfg.Backoff
+	case cfg.RetryInterval > 0:
+		interval := time.Duration(cfg.RetryInterval) * time.Second
+		c.backoff = Backoff{Initial: interval, Max: interval, Multiplier: 1}
+	default:
+		c.backoff = DefaultBackoff
 	}
+	c.staleTimeout = cfg.StaleTimeout
+	if c.staleTimeout <= 0 {
+		c.staleTimeout = defaultStaleTimeout
+	}
+	c.stateChanges = make(chan StateChange, stateChangeBuffer)
+	c.sessionEvents = make(chan SessionEvent, sessionEventBuffer)
+	c.sinkErrors = make(chan SinkError, sinkErrorBuffer)
+	c.sinks = cfg.Sinks
 	c.status = closed
 	c.varBufTickCount = 0
 	return c
 }
 
+// close stops the client and closes the source, the last session read is kept for
+// SessionInfoYaml, SessionInfo and QuerySession
 func (ir *Client) close() {
 	ir.logger.Debug("closing iracing client")
+	ir.closeSource()
+	ir.setState(Disconnected)
 	ir.logger.Sync()
 	ir.stop = true
-	ir.status = closed
 }
 
-// Use was taken from syscall package:
-// Use is a no-op, but the compiler cannot see that it is.
-// Calling Use(p) ensures that p is kept live until that point.
-func (ir *Client) use(unsafe.Pointer) {}
-
+// open will loop and wait for the sim to become available backing off between attempts.
 func (ir *Client) open() error {
 	if ir.status != closed {
-		return fmt.Errorf("invalid client status for open iracing mem mapped file status %d", ir.status)
+		return fmt.Errorf("invalid client status for open iracing source status %d", ir.status)
 	}
-	winHandle, err := ir.openIracingFile()
-	if err != nil {
-		return err
-	}
-	ir.logger.Debug("opening map view of file")
-	addr, err := windows.MapViewOfFile(windows.Handle(winHandle), uint32(windows.FILE_MAP_READ), 0, 0, 0)
-	if err != nil {
-		ir.logger.Error("Error creating map view of file",
-			zap.String("filename", iracingMemoryMappedFileName),
-			zap.Error(err))
-		return err
-	}
-	ir.logger.Debug("got map view of file", zap.Uintptr("address", addr))
-	ir.irMemMapedFile = addr
-	return nil
-}
 
-// openIracingFile will loop and wait for an iracing file to exist.
-func (ir *Client) openIracingFile() (uintptr, error) {
-	if ir.status != closed {
-		return 0, fmt.Errorf("invalid client status for openIracingFile status %d", ir.status)
-	}
-
-	// An iracing file only exists if iRacing is actually running
-	ir.logger.Debug("opening iracing memory mapped file", zap.String("filename", iracingMemoryMappedFileName))
-	ptrName, err := windows.UTF16PtrFromString(iracingMemoryMappedFileName)
-	if err != nil {
-		ir.logger.Error("Error creating windows pointer from file name",
-			zap.String("filename", iracingMemoryMappedFileName),
-			zap.Error(err))
-		return 0, nil
-	}
-	uPtrName := unsafe.Pointer(ptrName)
-	ir.logger.Debug("calling windows function OpenFileMappingW")
-	for !ir.stop && ir.status == closed {
-		// open the file and get a ptr
-		modkernel32 := windows.NewLazyDLL("kernel32.dll")
-		procOpenFileMapping := modkernel32.NewProc("OpenFileMappingW")
-		winHandle, _, err := procOpenFileMapping.Call(uintptr(4), uintptr(0), uintptr(uPtrName))
-		if winHandle == 0 && err != nil {
-			ir.logger.Debug("Error opening windows memory mapped file",
-				zap.String("filename", iracingMemoryMappedFileName),
-				zap.Error(err))
-			time.Sleep(time.Duration(ir.retryInterval) * time.Second)
+	ir.logger.Debug("opening iracing source")
+	for !ir.stop {
+		if err := ir.tryOpen(); err != nil {
+			return err
 		}
-		if winHandle > 0 {
-			ir.logger.Debug("got ptr to iracing mem mapped file", zap.Uintptr("ptr", winHandle))
-			ir.status = open
-			return winHandle, nil
+		if ir.status != closed {
+			return nil
 		}
+		time.Sleep(time.Until(ir.nextAttempt))
 	}
-	ir.use(uPtrName) // see use, if wierd stuff happens may have to look at winHandle too
-	return 0, nil
+	return fmt.Errorf("client stopped while opening iracing source")
 }
 
 func (ir *Client) readHeader() error {
-	if ir.status != open {
+	if ir.status < open {
 		return fmt.Errorf("invalid client status for readHeader status %d", ir.status)
 	}
-	// parse headerSlice into a new IRHeader struct
-	ir.header = newHeader(ir.irMemMapedFile)
+	// parse the start of the memory area into a new IRHeader struct
+	header, err := newHeader(ir.mem)
+	if err != nil {
+		return err
+	}
+	ir.header = header
 
 	if ir.sessionInfoTickCount != ir.header.SessionInfoTickCount {
 		ir.logger.Debug("Session info is new. Read session info.", zap.Int("oldTickCount", ir.sessionInfoTickCount), zap.Int("newTickCount", ir.header.SessionInfoTickCount))
@@ -271,12 +311,13 @@ func (ir *Client) readHeader() error {
 			zap.Int("numBuf", ir.header.NumBuf),
 			zap.Int("BufLen", ir.header.BufLen))
 		ir.sessionInfoTickCount = ir.header.SessionInfoTickCount
-		ir.readSession()
+		if err := ir.readSession(); err != nil {
+			return err
+		}
 	}
-	for _, bufInfo := range ir.header.BufInfos {
-		ir.logger.Debug("bufInfo", zap.Int("tick", bufInfo.TickCount), zap.Int("offset", bufInfo.BufOffset))
+	if ir.status < loadedHeader {
+		ir.status = loadedHeader
 	}
-	ir.status = loadedHeader
 	return nil
 }
 
@@ -284,109 +325,254 @@ func (ir *Client) readVarHeaders() error {
 	if ir.status < loadedHeader {
 		return fmt.Errorf("invalid client status for readVarHeaders status %d", ir.status)
 	}
-	// setup a slice around the variable headers data in the iracing ptr based on offet and variable header length
-	varHeaderSlice := Mmap{}
-	h := varHeaderSlice.Header()
-	h.Data = ir.irMemMapedFile + uintptr(ir.header.VarHeaderOffset)
-	h.Cap = varHeaderLenth * int(ir.header.NumVars)
-	h.Len = varHeaderLenth * int(ir.header.NumVars)
-
-	// initialize a map to store telementry variable headers mapped by name
-	varHeaders := make(map[string]*varHeader)
-
-	// loop through the variable headers byte slice to read ir.header.NumVars headers and create a variable header to add to map
-	for i := 0; i < int(ir.header.NumVars); i++ {
-		b := varHeaderSlice[i*varHeaderLenth : (i+1)*varHeaderLenth]
-		h := newVarHeader(b)
-		varHeaders[h.name] = h
-		// ir.logger.Debug("variable header",
-		// 	zap.String("name", h.name),
-		// 	zap.String("description", h.desc),
-		// 	zap.String("type", h.t.String()),
-		// )
+	// slice the variable headers data from the memory area based on offset and variable header length
+	varHeaderSlice, err := ir.slice(ir.header.VarHeaderOffset, varHeaderLenth*ir.header.NumVars)
+	if err != nil {
+		return fmt.Errorf("reading variable headers: %w", err)
 	}
+
+	// read ir.header.NumVars headers into a map of telemetry variable headers by name
+	varHeaders := parseVarHeaders(varHeaderSlice, ir.header.NumVars)
 	ir.logger.Debug("parsed variable headers", zap.Int("numvars", int(ir.header.NumVars)))
+
+	// lock the varBuf as readers use the variable headers and varBuf together
+	ir.varBufLock.Lock()
+	defer ir.varBufLock.Unlock()
 	ir.varHeaders = varHeaders
-	ir.status = loadedVarHeaders
+	ir.varHeaderBytes = append(ir.varHeaderBytes[:0], varHeaderSlice...)
+	if ir.status < loadedVarHeaders {
+		ir.status = loadedVarHeaders
+	}
 	return nil
 }
 
-// readVarBuf reads the buf info from the header and determines which
-// buf is the active buffer based on the tick count in the header
-// once found the current buffer is copied into the client varBuf slice
-// varBuf will then be used to access the variables
+// readVarBuf reads the buf infos from the memory area and determines which
+// buf is the active buffer based on the tick counts
+// once found the current buffer is copied into a new snapshot slice which becomes the client varBuf
+// varBuf will then be used to access the variables, a snapshot is never modified once taken
 func (ir *Client) readVarBuf() error {
 	if ir.status < loadedVarHeaders {
 		return fmt.Errorf("invalid client status for readVarBuf status %d", ir.status)
 	}
+	if len(ir.header.BufInfos) == 0 {
+		return fmt.Errorf("iracing header has no variable buffers")
+	}
 
-	// lock the varBuf to prevent multiple go routines accessing while updating
-	ir.varBufLock.Lock()
-	defer ir.varBufLock.Unlock()
+	// the sim may rewrite the buffer while it is copied, like the irsdk copy it then check the
+	// tick count of the buffer did not change during the copy and retry if it did
+	snapshot := make([]byte, ir.header.BufLen)
+	for try := 0; try < snapshotRetries; try++ {
+		curBuf := ir.newestBuf()
+		tickCount := ir.bufTickCount(curBuf)
+		varBuffer, err := ir.slice(ir.header.BufInfos[curBuf].BufOffset, ir.header.BufLen)
+		if err != nil {
+			return fmt.Errorf("reading variable buffer %d: %w", curBuf, err)
+		}
+		copyVarBuf(snapshot, varBuffer)
+		if ir.bufTickCount(curBuf) != tickCount {
+			ir.logger.Debug("variable buffer rewritten while copying", zap.Int("buffer", curBuf), zap.Int("try", try))
+			continue
+		}
 
-	// selecting buffer
+		// lock the varBuf to prevent multiple go routines accessing while updating
+		ir.varBufLock.Lock()
+		defer ir.varBufLock.Unlock()
+		ir.varBuf = snapshot
+		ir.varBufTickCount = tickCount
+		ir.varBufTickRate = ir.header.TickRate
+		ir.varBufTime = time.Now()
+		ir.status = loadedVarBuf
+		return nil
+	}
+	return errSnapshotTorn
+}
+
+// newestBuf returns the index of the buf with the highest tick count in the memory area
+func (ir *Client) newestBuf() int {
 	curBuf := 0
-	for i, bufInfo := range ir.header.BufInfos {
-		ir.logger.Debug("bufInfo", zap.Int("tickCount", bufInfo.TickCount), zap.Int("lastTick", ir.varBufTickCount))
-		// is this buffer from a more recent tick count
-		if bufInfo.TickCount > ir.varBufTickCount {
+	for i := range ir.header.BufInfos {
+		if ir.bufTickCount(i) > ir.bufTickCount(curBuf) {
 			curBuf = i
-			ir.varBufTickCount = bufInfo.TickCount
 		}
 	}
-	ir.logger.Debug("reading variable buffer", zap.Int("currentBuffer", curBuf))
+	return curBuf
+}
 
-	varBuffer := Mmap{}
-	h := varBuffer.Header()
-	h.Data = ir.irMemMapedFile + uintptr(ir.header.BufInfos[curBuf].BufOffset)
-	h.Cap = ir.header.BufLen
-	h.Len = ir.header.BufLen
-	ir.varBuf = varBuffer
-	ir.status = loadedVarBuf
-	return nil
+// bufTickCount reads the current tick count of buf i directly from the memory area
+// rather than the parsed header as the sim updates it as it writes the buffer
+func (ir *Client) bufTickCount(i int) int {
+	s := bufInfoOffset + i*bufInfoLength
+	return int(binary.LittleEndian.Uint32(ir.mem[s : s+4]))
 }
 
-func (ir *Client) readFloat32Var(varName string) float32 {
-	if ir.status != loadedVarBuf {
-		return 0
+// withSample calls read with a sample of the current variable buffer snapshot
+func (ir *Client) withSample(read func(s *Sample) error) error {
+	s := ir.Sample()
+	if s == nil {
+		return fmt.Errorf("no variable buffer has been read")
 	}
+	return read(s)
+}
+
+// Char returns the current value of the char variable named varName
+func (ir *Client) Char(varName string) (v byte, err error) {
+	err = ir.withSample(func(s *Sample) error { v, err = s.Char(varName); return err })
+	return v, err
+}
+
+// Chars returns the current values of the char variable named varName
+func (ir *Client) Chars(varName string) (v []byte, err error) {
+	err = ir.withSample(func(s *Sample) error { v, err = s.Chars(varName); return err })
+	return v, err
+}
+
+// Bool returns the current value of the bool variable named varName
+func (ir *Client) Bool(varName string) (v bool, err error) {
+	err = ir.withSample(func(s *Sample) error { v, err = s.Bool(varName); return err })
+	return v, err
+}
+
+// Bools returns the current values of the bool variable named varName
+func (ir *Client) Bools(varName string) (v []bool, err error) {
+	err = ir.withSample(func(s *Sample) error { v, err = s.Bools(varName); return err })
+	return v, err
+}
+
+// Int returns the current value of the int variable named varName
+func (ir *Client) Int(varName string) (v int32, err error) {
+	err = ir.withSample(func(s *Sample) error { v, err = s.Int(varName); return err })
+	return v, err
+}
+
+// Ints returns the current values of the int variable named varName
+func (ir *Client) Ints(varName string) (v []int32, err error) {
+	err = ir.withSample(func(s *Sample) error { v, err = s.Ints(varName); return err })
+	return v, err
+}
+
+// BitField returns the current value of the bitField variable named varName
+func (ir *Client) BitField(varName string) (v uint32, err error) {
+	err = ir.withSample(func(s *Sample) error { v, err = s.BitField(varName); return err })
+	return v, err
+}
 
-	// before reading vars make sure buffer isn't rewritten
+// BitFields returns the current values of the bitField variable named varName
+func (ir *Client) BitFields(varName string) (v []uint32, err error) {
+	err = ir.withSample(func(s *Sample) error { v, err = s.BitFields(varName); return err })
+	return v, err
+}
+
+// Float returns the current value of the float variable named varName
+func (ir *Client) Float(varName string) (v float32, err error) {
+	err = ir.withSample(func(s *Sample) error { v, err = s.Float(varName); return err })
+	return v, err
+}
+
+// Floats returns the current values of the float variable named varName
+func (ir *Client) Floats(varName string) (v []float32, err error) {
+	err = ir.withSample(func(s *Sample) error { v, err = s.Floats(varName); return err })
+	return v, err
+}
+
+// Double returns the current value of the double variable named varName
+func (ir *Client) Double(varName string) (v float64, err error) {
+	err = ir.withSample(func(s *Sample) error { v, err = s.Double(varName); return err })
+	return v, err
+}
+
+// Doubles returns the current values of the double variable named varName
+func (ir *Client) Doubles(varName string) (v []float64, err error) {
+	err = ir.withSample(func(s *Sample) error { v, err = s.Doubles(varName); return err })
+	return v, err
+}
+
+// Sample returns the snapshot of the most recently read variable buffer.
+// Returns nil if no variable buffer has been read yet.
+func (ir *Client) Sample() *Sample {
+	s, _ := ir.sampleTick()
+	return s
+}
+
+// sampleTick returns the snapshot of the most recently read variable buffer with its tick count,
+// read together so the tick count is the one of the snapshot. The sample is nil if no variable
+// buffer has been read yet.
+func (ir *Client) sampleTick() (*Sample, int) {
 	ir.varBufLock.Lock()
 	defer ir.varBufLock.Unlock()
-
-	vH := ir.varHeaders[varName]
-	if vH == nil {
-		return 0
+	if ir.varBuf == nil {
+		return nil, 0
 	}
+	return &Sample{varHeaders: ir.varHeaders, buf: ir.varBuf}, ir.varBufTickCount
+}
+
+// TickCount returns the tick count of the most recently read variable buffer
+func (ir *Client) TickCount() int {
+	ir.varBufLock.Lock()
+	defer ir.varBufLock.Unlock()
+	return ir.varBufTickCount
+}
+
+// SessionYaml returns the most recently read session info yaml.
+// Unlike SessionInfoYaml it may be called while Run is running.
+func (ir *Client) SessionYaml() string {
+	ir.sessionLock.Lock()
+	defer ir.sessionLock.Unlock()
+	return ir.sessionYaml
+}
 
-	raw := binary.LittleEndian.Uint32(ir.varBuf[vH.offset : vH.offset+4])
-	return math.Float32frombits(raw)
+// SessionInfo returns the most recently read session info parsed into go types.
+// Returns nil if no session info has been read yet.
+func (ir *Client) SessionInfo() *Session {
+	ir.sessionLock.Lock()
+	defer ir.sessionLock.Unlock()
+	return ir.session
 }
 
 func (ir *Client) readSession() error {
-	if ir.status > loadedHeader {
+	if ir.status < open {
 		return fmt.Errorf("invalid client status for readSession status %d", ir.status)
 	}
-	// setup a slice around the area of the file with the session data in it
-	// we'll find a more efficient way to deal with this once its working
-	sessionInfoSlice := Mmap{}
-	// set the slice header to make this slice point to our data
-	h := sessionInfoSlice.Header()
-	h.Data = ir.irMemMapedFile + uintptr(ir.header.SessionInfoOffset)
-	h.Cap = int(ir.header.SessionInfoLen)
-	h.Len = int(ir.header.SessionInfoLen)
-
-	// h.Cap = int(ir.irHeader.SessionInfoLen + ir.irHeader.SessionInfoOffset)
-	// h.Len = int(ir.irHeader.SessionInfoLen + ir.irHeader.SessionInfoOffset)
+	// slice the area of the memory with the session data in it
+	sessionInfoSlice, err := ir.slice(ir.header.SessionInfoOffset, ir.header.SessionInfoLen)
+	if err != nil {
+		return fmt.Errorf("reading session info: %w", err)
+	}
 
-	// TODO Working here trying to read the session info data. Having issues with the size of the info data
-	infoStr := windows.ByteSliceToString(sessionInfoSlice)
+	// the sim writes the session info ISO-8859-1 encoded
+	infoStr := decodeSessionString(sessionInfoSlice)
 
 	ir.SessionInfoYaml = infoStr
+	session, err := ParseSessionInfo(infoStr)
+	if err != nil {
+		ir.logger.Warn("error parsing session info", zap.Error(err))
+	}
+	tree, err := parseSessionTree(infoStr)
+	if err != nil {
+		ir.logger.Warn("error parsing session info for queries", zap.Error(err))
+	}
+	ir.sessionLock.Lock()
+	ir.sessionYaml = infoStr
+	var events []SessionEvent
+	if session != nil {
+		events = diffSession(ir.session, session)
+		ir.session = session
+	}
+	if tree != nil {
+		ir.sessionTree = tree
+	}
+	ir.sessionLock.Unlock()
+	ir.emitSessionEvents(events)
 	return nil
 }
 
+// slice returns length bytes of the memory area starting at offset
+func (ir *Client) slice(offset, length int) ([]byte, error) {
+	if offset < 0 || length < 0 || offset+length > len(ir.mem) {
+		return nil, fmt.Errorf("range %d:%d outside of iracing memory length %d", offset, offset+length, len(ir.mem))
+	}
+	return ir.mem[offset : offset+length], nil
+}
+
 func newLogger(debug bool) *zap.Logger {
 	var level string
 	if debug {
@@ -0,0 +1,34 @@
+package iracing
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRecordStopsOnCancel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "record.ibt")
+	client := NewClient(&ClientConfig{Source: NewMemorySource(newTestMemory("---\n", []testVar{{"Speed", 42}}, 1))})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := client.Record(ctx, path, nil); err != nil {
+		t.Fatalf("Record: %v", err)
+	}
+	if client.State() != Disconnected {
+		t.Errorf("state %v want disconnected", client.State())
+	}
+
+	r, err := OpenIBT(path)
+	if err != nil {
+		t.Fatalf("OpenIBT: %v", err)
+	}
+	defer r.Close()
+	if !r.Next() {
+		t.Fatalf("no records: %v", r.Err())
+	}
+	if v, err := r.Sample().Float("Speed"); err != nil || v != 42 {
+		t.Errorf("Speed got %v %v", v, err)
+	}
+}
@@ -0,0 +1,192 @@
+package iracing
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// irsdkStConnected is the bit of IRHeader.Status set while the sim is running
+const irsdkStConnected = 1
+
+// defaultStaleTimeout is how long a connected sim may go without writing new data before it is stale
+const defaultStaleTimeout = 2 * time.Second
+
+// stateChangeBuffer is the number of state changes buffered for StateChanges before new ones are dropped
+const stateChangeBuffer = 16
+
+// ConnectionState is the state of the client connection to the sim
+type ConnectionState int
+
+const (
+	// Disconnected the client is not attached to the sim, either not yet opened or the sim exited
+	Disconnected ConnectionState = iota
+	// Waiting the client is waiting for the sim to start
+	Waiting
+	// Connected the client is attached and the sim is writing data
+	Connected
+	// Stale the client is attached but the sim has not written new data for the stale timeout
+	Stale
+)
+
+func (s ConnectionState) String() string {
+	return [...]string{"disconnected", "waiting", "connected", "stale"}[s]
+}
+
+// MarshalText encodes the state as its name
+func (s ConnectionState) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes the state from its name
+func (s *ConnectionState) UnmarshalText(b []byte) error {
+	for cs := Disconnected; cs <= Stale; cs++ {
+		if cs.String() == string(b) {
+			*s = cs
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown connection state %s", b)
+}
+
+// StateChange is sent on the client state changes channel on each connection state transition
+type StateChange struct {
+	From ConnectionState
+	To   ConnectionState
+	Time time.Time
+}
+
+// Backoff configures the delay between attempts to attach to the sim.
+// The delay starts at Initial and is multiplied by Multiplier after each failed attempt up to Max.
+type Backoff struct {
+	Initial    time.Duration
+	Max        time.Duration
+	Multiplier float64
+}
+
+// DefaultBackoff is used when ClientConfig has no Backoff or RetryInterval
+var DefaultBackoff = Backoff{
+	Initial:    500 * time.Millisecond,
+	Max:        10 * time.Second,
+	Multiplier: 2,
+}
+
+// delay returns the delay after the given number of failed attempts
+func (b Backoff) delay(attempts int) time.Duration {
+	d := b.Initial
+	for i := 1; i < attempts && d < b.Max; i++ {
+		d = time.Duration(float64(d) * b.Multiplier)
+	}
+	if b.Max > 0 && d > b.Max {
+		d = b.Max
+	}
+	return d
+}
+
+// State returns the current connection state
+func (ir *Client) State() ConnectionState {
+	ir.stateLock.Lock()
+	defer ir.stateLock.Unlock()
+	return ir.state
+}
+
+// StateChanges returns a channel receiving every connection state transition.
+// Transitions are dropped if the channel is not read and its buffer fills.
+func (ir *Client) StateChanges() <-chan StateChange {
+	return ir.stateChanges
+}
+
+func (ir *Client) setState(state ConnectionState) {
+	ir.stateLock.Lock()
+	from := ir.state
+	ir.state = state
+	ir.stateLock.Unlock()
+	if from == state {
+		return
+	}
+
+	ir.logger.Info("iracing connection state changed", zap.Stringer("from", from), zap.Stringer("to", state))
+	select {
+	case ir.stateChanges <- StateChange{From: from, To: state, Time: time.Now()}:
+	default:
+		ir.logger.Warn("state change dropped, state changes channel full", zap.Stringer("to", state))
+	}
+}
+
+// tryOpen makes an attempt to attach to the sim if the backoff delay since the last attempt has passed.
+// The client is only attached once the sim sets the connected bit in the header status.
+// Errors other than the source being unavailable are returned.
+func (ir *Client) tryOpen() error {
+	if time.Now().Before(ir.nextAttempt) {
+		return nil
+	}
+	err := ir.source.Open()
+	if err == nil {
+		ir.mem = ir.source.Bytes()
+		ir.status = open
+		if err = ir.readHeader(); err == nil && ir.header.Status&irsdkStConnected != 0 {
+			ir.logger.Debug("opened iracing source", zap.Int("length", len(ir.mem)))
+			ir.attempts = 0
+			ir.nextAttempt = time.Time{}
+			ir.lastData = time.Now()
+			ir.setState(Connected)
+			return nil
+		}
+		// the memory is there but the sim is not running in it
+		ir.detach()
+		if err != nil {
+			return err
+		}
+		err = ErrSourceUnavailable
+	}
+	if !errors.Is(err, ErrSourceUnavailable) {
+		return err
+	}
+
+	ir.attempts++
+	delay := ir.backoff.delay(ir.attempts)
+	ir.nextAttempt = time.Now().Add(delay)
+	ir.logger.Debug("iracing not available", zap.Error(err), zap.Int("attempts", ir.attempts), zap.Duration("retry", delay))
+	ir.setState(Waiting)
+	return nil
+}
+
+// detach closes the source and resets what was read from it so the client can re-attach
+// to a restarted sim. The session of the restarted sim is not compared with the old one.
+func (ir *Client) detach() {
+	ir.closeSource()
+	ir.resetSession()
+}
+
+// closeSource closes the source and resets the variables read from it, keeping the session
+func (ir *Client) closeSource() {
+	if err := ir.source.Close(); err != nil {
+		ir.logger.Error("error closing iracing source", zap.Error(err))
+	}
+	ir.varBufLock.Lock()
+	ir.varBuf = nil
+	ir.varHeaders = nil
+	ir.varHeaderBytes = nil
+	ir.varBufTickCount = 0
+	ir.varBufTickRate = 0
+	ir.varBufTime = time.Time{}
+	ir.sessionInfoTickCount = 0
+	ir.varBufLock.Unlock()
+	ir.mem = nil
+	ir.header = nil
+	ir.status = closed
+}
+
+// resetSession forgets the session read from the sim so the session of the next one is read
+func (ir *Client) resetSession() {
+	ir.sessionLock.Lock()
+	ir.sessionYaml = ""
+	ir.session = nil
+	ir.sessionTree = nil
+	ir.sessionLock.Unlock()
+	ir.SessionInfoYaml = ""
+	ir.updateSessionTick = 0
+	ir.sessionNumRead = false
+}
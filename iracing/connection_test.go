@@ -0,0 +1,122 @@
+package iracing
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBackoffDelay(t *testing.T) {
+	b := Backoff{Initial: time.Second, Max: 5 * time.Second, Multiplier: 2}
+	for attempts, want := range []time.Duration{time.Second, time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if got := b.delay(attempts); got != want {
+			t.Errorf("delay after %d attempts got %v want %v", attempts, got, want)
+		}
+	}
+}
+
+func TestConnectionLifecycle(t *testing.T) {
+	mem := newTestMemory("---\n", []testVar{{"Speed", 10}}, 0)
+	client := NewClient(&ClientConfig{
+		Source:       NewMemorySource(mem),
+		Backoff:      &Backoff{Initial: time.Millisecond, Max: time.Millisecond, Multiplier: 1},
+		StaleTimeout: 5 * time.Millisecond,
+	})
+	defer client.close()
+
+	if update, err := client.WaitForData(10 * time.Millisecond); err != nil || update == nil {
+		t.Fatalf("WaitForData got %v %v", update, err)
+	}
+	if client.State() != Connected {
+		t.Fatalf("state %v want connected", client.State())
+	}
+
+	// no new data from the sim
+	if _, err := client.WaitForData(20 * time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if client.State() != Stale {
+		t.Fatalf("state %v want stale", client.State())
+	}
+
+	// the sim exits
+	mem[4] = 0
+	if _, err := client.WaitForData(10 * time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if client.State() != Waiting {
+		t.Fatalf("state %v want waiting", client.State())
+	}
+
+	// the sim restarts
+	mem[4] = irsdkStConnected
+	if update, err := client.WaitForData(20 * time.Millisecond); err != nil || update == nil || !update.VarHeadersChanged {
+		t.Fatalf("WaitForData after restart got %+v %v", update, err)
+	}
+
+	want := []ConnectionState{Connected, Stale, Disconnected, Waiting, Connected}
+	for i, to := range want {
+		select {
+		case change := <-client.StateChanges():
+			if change.To != to {
+				t.Errorf("state change %d to %v want %v", i, change.To, to)
+			}
+		default:
+			t.Fatalf("missing state change %d to %v", i, to)
+		}
+	}
+}
+
+func TestDetachResetsSession(t *testing.T) {
+	session := "---\nDriverInfo:\n Drivers:\n - CarIdx: 1\n   UserID: 1\n"
+	mem := newTestMemory(session, []testVar{{"Speed", 10}}, 0)
+	client := NewClient(&ClientConfig{
+		Source:  NewMemorySource(mem),
+		Backoff: &Backoff{Initial: time.Millisecond, Max: time.Millisecond, Multiplier: 1},
+	})
+	defer client.close()
+	if update, err := client.WaitForData(10 * time.Millisecond); err != nil || update == nil {
+		t.Fatalf("WaitForData got %v %v", update, err)
+	}
+
+	// the sim exits
+	mem[4] = 0
+	if _, err := client.WaitForData(10 * time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if client.SessionYaml() != "" || client.SessionInfo() != nil || client.TickCount() != 0 || client.Sample() != nil {
+		t.Errorf("session %q %v tick count %d kept after the sim exited", client.SessionYaml(), client.SessionInfo(), client.TickCount())
+	}
+
+	// the sim restarts with another driver in the car, which is not a driver swap
+	copy(mem[112:], strings.Replace(session, "UserID: 1", "UserID: 2", 1))
+	mem[4] = irsdkStConnected
+	if update, err := client.WaitForData(20 * time.Millisecond); err != nil || update == nil || !update.SessionInfoChanged {
+		t.Fatalf("WaitForData after restart got %+v %v", update, err)
+	}
+	if d := client.SessionInfo().Driver(1); d == nil || d.UserID != 2 {
+		t.Errorf("driver after restart %+v", d)
+	}
+	select {
+	case e := <-client.SessionEvents():
+		t.Errorf("unexpected session event %+v after restart", e)
+	default:
+	}
+}
+
+func TestReadSessionKeepsSession(t *testing.T) {
+	session := "---\nWeekendInfo:\n TrackName: spa\n"
+	client := NewClient(&ClientConfig{Source: NewMemorySource(newTestMemory(session, []testVar{{"Speed", 10}}, 0))})
+	if err := client.ReadSession(); err != nil {
+		t.Fatal(err)
+	}
+	if client.SessionInfoYaml != session {
+		t.Errorf("session info %q after ReadSession", client.SessionInfoYaml)
+	}
+	if v, err := client.QuerySession("WeekendInfo:TrackName:"); err != nil || v != "spa" {
+		t.Errorf("QuerySession got %v %v", v, err)
+	}
+	if client.State() != Disconnected {
+		t.Errorf("state %v after ReadSession want disconnected", client.State())
+	}
+}
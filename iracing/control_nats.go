@@ -0,0 +1,226 @@
+package iracing
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+// NATSControlOptions configures the nats control endpoints
+type NATSControlOptions struct {
+	NATSConnOptions `mapstructure:",squash"`
+
+	// Prefix of the request subjects, defaults to iracing.control. Requests are served on
+	// <prefix>.session, .variables, .values, .status, .record.start, .record.stop, .vars and .marker
+	Prefix string
+	Queue  string // queue group of the subscriptions so several emitters can share the subjects, none if empty
+
+	// RecordDir is the directory recordings are written to, defaults to the working directory.
+	// Recording paths are relative to it and may not leave it.
+	RecordDir string
+}
+
+// ControlRequest is the json body of a control request, each endpoint uses the fields it needs.
+// An empty body is a request with no fields set.
+type ControlRequest struct {
+	Format string   // session info format, yaml or json, defaults to yaml
+	Vars   []string // variables of values, a recording or a sink
+	Path   string   // ibt file of a recording relative to the record dir
+	Sink   string   // sink of a vars change
+	Name   string   // name of a marker
+}
+
+// ControlReply is the json body of a control reply
+type ControlReply struct {
+	Data  interface{} `json:",omitempty"`
+	Error string      `json:",omitempty"`
+}
+
+// controlHandler serves a control request
+type controlHandler func(req *ControlRequest) (interface{}, error)
+
+// NATSControl serves request/reply control endpoints for a client over nats so a headless emitter
+// can be asked for its session info, variables, latest values and status, and told to start and stop
+// recordings, change the variables of a sink and add markers.
+type NATSControl struct {
+	client *Client
+	opts   NATSControlOptions
+
+	lock sync.Mutex
+	nc   *nats.Conn
+	subs []*nats.Subscription
+}
+
+// NewNATSControl creates the control endpoints of client, Start serves them
+func NewNATSControl(client *Client, opts *NATSControlOptions) *NATSControl {
+	c := &NATSControl{client: client, opts: *opts}
+	if c.opts.Prefix == "" {
+		c.opts.Prefix = "iracing.control"
+	}
+	if c.opts.RecordDir == "" {
+		c.opts.RecordDir = "."
+	}
+	return c
+}
+
+// handlers returns the handler of each subject suffix
+func (c *NATSControl) handlers() map[string]controlHandler {
+	return map[string]controlHandler{
+		"session":      c.session,
+		"variables":    c.variables,
+		"values":       c.values,
+		"status":       c.status,
+		"record.start": c.startRecording,
+		"record.stop":  c.stopRecording,
+		"vars":         c.setVars,
+		"marker":       c.marker,
+	}
+}
+
+// Start connects to the nats servers and subscribes to the request subjects
+func (c *NATSControl) Start() error {
+	nc, err := c.opts.connect()
+	if err != nil {
+		return err
+	}
+	var subs []*nats.Subscription
+	for suffix, h := range c.handlers() {
+		subject := c.opts.Prefix + "." + suffix
+		sub, err := nc.QueueSubscribe(subject, c.opts.Queue, c.serve(subject, h))
+		if err != nil {
+			nc.Close()
+			return fmt.Errorf("subscribing to %s: %w", subject, err)
+		}
+		subs = append(subs, sub)
+	}
+	if err := nc.Flush(); err != nil {
+		nc.Close()
+		return err
+	}
+	c.lock.Lock()
+	c.nc, c.subs = nc, subs
+	c.lock.Unlock()
+	c.client.logger.Info("serving nats control", zap.String("prefix", c.opts.Prefix))
+	return nil
+}
+
+// Close stops serving requests, letting requests being served finish
+func (c *NATSControl) Close() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.nc == nil {
+		return nil
+	}
+	err := c.nc.Drain()
+	c.nc, c.subs = nil, nil
+	return err
+}
+
+// serve returns the message handler replying to requests with the result of h
+func (c *NATSControl) serve(subject string, h controlHandler) nats.MsgHandler {
+	return func(msg *nats.Msg) {
+		var reply ControlReply
+		req := &ControlRequest{}
+		if len(strings.TrimSpace(string(msg.Data))) > 0 {
+			if err := json.Unmarshal(msg.Data, req); err != nil {
+				reply.Error = fmt.Sprintf("decoding request: %v", err)
+			}
+		}
+		if reply.Error == "" {
+			data, err := h(req)
+			if err != nil {
+				reply.Error = err.Error()
+			} else {
+				reply.Data = data
+			}
+		}
+		if reply.Error != "" {
+			c.client.logger.Warn("control request failed", zap.String("subject", subject), zap.String("error", reply.Error))
+		}
+		if msg.Reply == "" {
+			return
+		}
+		b, err := json.Marshal(reply)
+		if err != nil {
+			b, _ = json.Marshal(ControlReply{Error: fmt.Sprintf("encoding reply: %v", err)})
+		}
+		if err := msg.Respond(b); err != nil {
+			c.client.logger.Warn("error replying to control request", zap.String("subject", subject), zap.Error(err))
+		}
+	}
+}
+
+func (c *NATSControl) session(req *ControlRequest) (interface{}, error) {
+	switch req.Format {
+	case "", "yaml":
+		return c.client.SessionYaml(), nil
+	case "json":
+		return c.client.SessionInfo(), nil
+	}
+	return nil, fmt.Errorf("unknown session info format %s", req.Format)
+}
+
+func (c *NATSControl) variables(req *ControlRequest) (interface{}, error) {
+	return c.client.Vars(), nil
+}
+
+// values returns the latest values of the requested variables, every variable if none are requested
+func (c *NATSControl) values(req *ControlRequest) (interface{}, error) {
+	return c.client.Frame(req.Vars)
+}
+
+func (c *NATSControl) status(req *ControlRequest) (interface{}, error) {
+	return c.client.Status(), nil
+}
+
+func (c *NATSControl) startRecording(req *ControlRequest) (interface{}, error) {
+	path, err := c.recordPath(req.Path)
+	if err != nil {
+		return nil, err
+	}
+	return nil, c.client.StartRecording(path, req.Vars)
+}
+
+func (c *NATSControl) stopRecording(req *ControlRequest) (interface{}, error) {
+	path, err := c.recordPath(req.Path)
+	if err != nil {
+		return nil, err
+	}
+	return c.client.StopRecording(path)
+}
+
+// recordPath returns the path of a requested recording in the record dir. Absolute paths, paths
+// leaving the record dir and files other than .ibt files are rejected so requests cannot overwrite
+// other files.
+func (c *NATSControl) recordPath(path string) (string, error) {
+	if path == "" {
+		return "", fmt.Errorf("recording has no path")
+	}
+	clean := filepath.Clean(filepath.FromSlash(path))
+	if filepath.IsAbs(clean) || filepath.VolumeName(clean) != "" || strings.HasPrefix(clean, string(filepath.Separator)) {
+		return "", fmt.Errorf("recording path %s must be relative to the record dir", path)
+	}
+	if clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("recording path %s is outside of the record dir", path)
+	}
+	if !strings.EqualFold(filepath.Ext(clean), ".ibt") {
+		return "", fmt.Errorf("recording path %s is not an .ibt file", path)
+	}
+	return filepath.Join(c.opts.RecordDir, clean), nil
+}
+
+func (c *NATSControl) setVars(req *ControlRequest) (interface{}, error) {
+	return nil, c.client.SetSinkVars(req.Sink, req.Vars)
+}
+
+func (c *NATSControl) marker(req *ControlRequest) (interface{}, error) {
+	if req.Name == "" {
+		return nil, fmt.Errorf("marker has no name")
+	}
+	return c.client.Mark(req.Name), nil
+}
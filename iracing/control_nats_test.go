@@ -0,0 +1,108 @@
+package iracing
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+// controlRequest sends a control request and decodes the reply data into data
+func controlRequest(t *testing.T, nc *nats.Conn, suffix string, req *ControlRequest, data interface{}) string {
+	t.Helper()
+	var body []byte
+	if req != nil {
+		var err error
+		if body, err = json.Marshal(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	msg, err := nc.Request("iracing.control."+suffix, body, time.Second)
+	if err != nil {
+		t.Fatalf("%s: %v", suffix, err)
+	}
+	reply := ControlReply{Data: data}
+	if err := json.Unmarshal(msg.Data, &reply); err != nil {
+		t.Fatalf("%s reply %s: %v", suffix, msg.Data, err)
+	}
+	return reply.Error
+}
+
+func TestNATSControl(t *testing.T) {
+	srv := runNATSServer(t)
+	session := "---\nWeekendInfo:\n TrackName: spa\n"
+	client := newTestClient(t, session, []testVar{{"Speed", 42}, {"RPM", 6000}})
+
+	dir := t.TempDir()
+	control := NewNATSControl(client, &NATSControlOptions{NATSConnOptions: NATSConnOptions{URL: srv.ClientURL()}, RecordDir: dir})
+	if err := control.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer control.Close()
+	nc, err := nats.Connect(srv.ClientURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer nc.Close()
+
+	var yaml string
+	if e := controlRequest(t, nc, "session", nil, &yaml); e != "" || yaml != session {
+		t.Errorf("session got %q %s", yaml, e)
+	}
+	var vars []VarInfo
+	if e := controlRequest(t, nc, "variables", nil, &vars); e != "" || len(vars) != 2 || vars[0].Name != "Speed" {
+		t.Errorf("variables got %+v %s", vars, e)
+	}
+	var frame Frame
+	if e := controlRequest(t, nc, "values", &ControlRequest{Vars: []string{"RPM"}}, &frame); e != "" || frame.TickCount != 100 || frame.Values["RPM"] != 6000.0 || len(frame.Values) != 1 {
+		t.Errorf("values got %+v %s", frame, e)
+	}
+	var status map[string]interface{}
+	if e := controlRequest(t, nc, "status", nil, &status); e != "" || status["State"] != "connected" {
+		t.Errorf("status got %v %s", status, e)
+	}
+	if e := controlRequest(t, nc, "vars", &ControlRequest{Sink: "nats", Vars: []string{"Speed"}}, nil); e == "" {
+		t.Error("expected error changing variables of sinks that are not running")
+	}
+
+	for _, p := range []string{filepath.Join(dir, "abs.ibt"), "../up.ibt", "a/../../up.ibt", "control.yaml", ""} {
+		if e := controlRequest(t, nc, "record.start", &ControlRequest{Path: p}, nil); e == "" {
+			t.Errorf("expected error recording to %q", p)
+		}
+	}
+	if recs := client.Recordings(); len(recs) != 0 {
+		t.Errorf("unexpected recordings %+v", recs)
+	}
+	if e := controlRequest(t, nc, "record.start", &ControlRequest{Path: "control.ibt", Vars: []string{"Speed"}}, nil); e != "" {
+		t.Fatalf("record.start: %s", e)
+	}
+	client.record(client.Sample())
+	var marker Marker
+	if e := controlRequest(t, nc, "marker", &ControlRequest{Name: "apex"}, &marker); e != "" || marker.Name != "apex" || marker.TickCount != 100 {
+		t.Errorf("marker got %+v %s", marker, e)
+	}
+	if e := <-client.SessionEvents(); e.Type != MarkerAdded || e.Marker.Name != "apex" {
+		t.Errorf("unexpected session event %+v", e)
+	}
+	var rec Recording
+	if e := controlRequest(t, nc, "record.stop", &ControlRequest{Path: "control.ibt"}, &rec); e != "" || rec.Samples != 1 || len(rec.Markers) != 1 {
+		t.Errorf("record.stop got %+v %s", rec, e)
+	}
+	if e := controlRequest(t, nc, "record.stop", &ControlRequest{Path: "control.ibt"}, nil); e == "" {
+		t.Error("expected error stopping a stopped recording")
+	}
+
+	r, err := OpenIBT(filepath.Join(dir, "control.ibt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if !r.Next() {
+		t.Fatalf("no records: %v", r.Err())
+	}
+	if v, err := r.Sample().Float("Speed"); err != nil || v != 42 {
+		t.Errorf("Speed got %v %v", v, err)
+	}
+}
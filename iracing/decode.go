@@ -0,0 +1,258 @@
+package iracing
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// decodePlan is the fields of a struct type decoded from variables
+type decodePlan struct {
+	fields  []decodeField
+	binding atomic.Value // *decodeBinding of the variable headers last decoded from
+}
+
+// decodeField is a struct field tagged with the variable it is decoded from
+type decodeField struct {
+	index     []int
+	name      string // name of the struct field
+	typ       reflect.Type
+	varName   string
+	omitEmpty bool // leave the field unchanged when the variable is missing
+}
+
+// decodeBinding is a decodePlan bound to the variable headers of a sample, so each field is
+// decoded straight from the variable buffer
+type decodeBinding struct {
+	headers map[string]*varHeader
+	fields  []boundField
+}
+
+// boundField is where a field is decoded from in the variable buffer and how
+type boundField struct {
+	index   []int
+	varName string
+	offset  int
+	end     int
+	set     fieldSetter
+	skip    bool  // omitempty field of a missing variable
+	err     error // why the field can not be decoded
+}
+
+// fieldSetter sets a field from the bytes of its variable
+type fieldSetter func(dst reflect.Value, b []byte)
+
+// decodePlans caches the decodePlan of each struct type by reflect.Type
+var decodePlans sync.Map
+
+// planFor returns the decode plan of the struct type t
+func planFor(t reflect.Type) *decodePlan {
+	if p, ok := decodePlans.Load(t); ok {
+		return p.(*decodePlan)
+	}
+	p := &decodePlan{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, ok := f.Tag.Lookup("irsdk")
+		if !ok || tag == "-" || f.PkgPath != "" {
+			continue
+		}
+		name, opts := tag, ""
+		if i := strings.IndexByte(tag, ','); i >= 0 {
+			name, opts = tag[:i], tag[i+1:]
+		}
+		if name == "" {
+			name = f.Name
+		}
+		p.fields = append(p.fields, decodeField{
+			index:     f.Index,
+			name:      f.Name,
+			typ:       f.Type,
+			varName:   name,
+			omitEmpty: opts == "omitempty",
+		})
+	}
+	actual, _ := decodePlans.LoadOrStore(t, p)
+	return actual.(*decodePlan)
+}
+
+// bind returns the plan bound to headers, reusing the last binding while the headers are the same
+func (p *decodePlan) bind(headers map[string]*varHeader) *decodeBinding {
+	if b, ok := p.binding.Load().(*decodeBinding); ok && reflect.ValueOf(b.headers).Pointer() == reflect.ValueOf(headers).Pointer() {
+		return b
+	}
+	b := &decodeBinding{headers: headers, fields: make([]boundField, len(p.fields))}
+	for i, f := range p.fields {
+		b.fields[i] = f.bind(headers[f.varName])
+	}
+	p.binding.Store(b)
+	return b
+}
+
+// bind returns how the field is decoded from the variable of h, h is nil if the variable is missing
+func (f *decodeField) bind(h *varHeader) boundField {
+	bf := boundField{index: f.index, varName: f.varName}
+	switch {
+	case h == nil && f.omitEmpty:
+		bf.skip = true
+		return bf
+	case h == nil:
+		bf.err = fmt.Errorf("%w: %s", ErrUnknownVar, f.varName)
+		return bf
+	case h.count < 1:
+		bf.err = fmt.Errorf("variable %s has no values", f.varName)
+		return bf
+	}
+	bf.offset, bf.end = h.offset, h.offset+h.length()
+	set, err := newFieldSetter(h, f.typ)
+	if err != nil {
+		bf.err = fmt.Errorf("%w: decoding %s into %s: %v", ErrVarType, f.varName, f.name, err)
+	}
+	bf.set = set
+	return bf
+}
+
+// Decode sets the fields of the struct pointed to by v from the variables named in their irsdk tags,
+// e.g.
+//
+//	type Telemetry struct {
+//		LFShockDefl  float32      `irsdk:"LFshockDefl"`
+//		Flags        SessionFlags `irsdk:"SessionFlags"`
+//		TrackSurface []TrkLoc     `irsdk:"CarIdxTrackSurface"`
+//		Gear         int          `irsdk:"Gear,omitempty"`
+//	}
+//
+// Values are converted to the field type so an int variable may be decoded into any integer field,
+// enum variables into their enum type and array variables into slices or arrays. Fields tagged
+// omitempty are left unchanged when the variable is missing, other missing variables return an
+// error wrapping ErrUnknownVar.
+func (s *Sample) Decode(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("decode needs a non nil pointer to a struct not %T", v)
+	}
+	rv = rv.Elem()
+	b := planFor(rv.Type()).bind(s.varHeaders)
+	for i := range b.fields {
+		f := &b.fields[i]
+		switch {
+		case f.skip:
+			continue
+		case f.err != nil:
+			return f.err
+		case f.end > len(s.buf):
+			return fmt.Errorf("variable %s at %d:%d outside of buffer length %d", f.varName, f.offset, f.end, len(s.buf))
+		}
+		f.set(rv.FieldByIndex(f.index), s.buf[f.offset:f.end])
+	}
+	return nil
+}
+
+// Decode sets the fields of the struct pointed to by v from the current variable values.
+// See Sample.Decode for the struct tags.
+func (ir *Client) Decode(v interface{}) error {
+	return ir.withSample(func(s *Sample) error { return s.Decode(v) })
+}
+
+// Decode sets the fields of the struct pointed to by v from the current record.
+// See Sample.Decode for the struct tags.
+func (ibt *IBTReader) Decode(v interface{}) error {
+	return ibt.Sample().Decode(v)
+}
+
+// newFieldSetter returns the setter of fields of type t from the values of the variable of h.
+// Numbers are converted to any numeric field, bools only to bool fields and array variables to
+// slices or arrays of those.
+func newFieldSetter(h *varHeader, t reflect.Type) (fieldSetter, error) {
+	if h.count == 1 {
+		return newValueSetter(h.t, t)
+	}
+	if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+		return nil, fmt.Errorf("can not assign %d %s values to %s", h.count, h.t, t)
+	}
+	set, err := newValueSetter(h.t, t.Elem())
+	if err != nil {
+		return nil, err
+	}
+	size, count := h.t.size(), h.count
+	if t.Kind() == reflect.Array {
+		if t.Len() < count {
+			count = t.Len()
+		}
+		return func(dst reflect.Value, b []byte) {
+			for i := 0; i < count; i++ {
+				set(dst.Index(i), b[i*size:])
+			}
+		}, nil
+	}
+	return func(dst reflect.Value, b []byte) {
+		if dst.Len() != count {
+			dst.Set(reflect.MakeSlice(dst.Type(), count, count))
+		}
+		for i := 0; i < count; i++ {
+			set(dst.Index(i), b[i*size:])
+		}
+	}, nil
+}
+
+// newValueSetter returns the setter of values of type t from a single value of type vt
+func newValueSetter(vt varType, t reflect.Type) (fieldSetter, error) {
+	k := t.Kind()
+	if vt == irbool || k == reflect.Bool {
+		if vt != irbool || k != reflect.Bool {
+			return nil, fmt.Errorf("can not assign %s to %s", vt, t)
+		}
+		return func(dst reflect.Value, b []byte) { dst.SetBool(b[0] != 0) }, nil
+	}
+	switch {
+	case k >= reflect.Int && k <= reflect.Int64:
+		return func(dst reflect.Value, b []byte) {
+			i, f, isFloat := readNumber(vt, b)
+			if isFloat {
+				i = int64(f)
+			}
+			dst.SetInt(i)
+		}, nil
+	case k >= reflect.Uint && k <= reflect.Uintptr:
+		return func(dst reflect.Value, b []byte) {
+			i, f, isFloat := readNumber(vt, b)
+			if isFloat {
+				dst.SetUint(uint64(f))
+				return
+			}
+			dst.SetUint(uint64(i))
+		}, nil
+	case k == reflect.Float32 || k == reflect.Float64:
+		return func(dst reflect.Value, b []byte) {
+			i, f, isFloat := readNumber(vt, b)
+			if !isFloat {
+				f = float64(i)
+			}
+			dst.SetFloat(f)
+		}, nil
+	}
+	return nil, fmt.Errorf("can not assign %s to %s", vt, t)
+}
+
+// readNumber reads a single value of the numeric type vt from b, as an integer or for float and
+// double types as a float
+func readNumber(vt varType, b []byte) (i int64, f float64, isFloat bool) {
+	le := binary.LittleEndian
+	switch vt {
+	case irchar:
+		return int64(b[0]), 0, false
+	case irint:
+		return int64(int32(le.Uint32(b))), 0, false
+	case irbitField:
+		return int64(le.Uint32(b)), 0, false
+	case irfloat:
+		return 0, float64(math.Float32frombits(le.Uint32(b))), true
+	case irdouble:
+		return 0, math.Float64frombits(le.Uint64(b)), true
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,126 @@
+package iracing
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+)
+
+func TestSampleDecode(t *testing.T) {
+	var v struct {
+		SessionTime float64    `irsdk:"SessionTime"`
+		Lap         int        `irsdk:"Lap"`
+		Speed       float64    `irsdk:"Speed"`
+		Shocks      []float32  `irsdk:"ShockDefl"`
+		FirstShocks [2]float64 `irsdk:"ShockDefl"`
+		Gear        int        `irsdk:"Gear,omitempty"`
+		Ignored     string
+	}
+	v.Gear = 3
+	s := newTestSample(1.5, 4, 20)
+	if err := s.Decode(&v); err != nil {
+		t.Fatal(err)
+	}
+	if v.SessionTime != 1.5 || v.Lap != 4 || v.Speed != 20 || v.Gear != 3 {
+		t.Errorf("unexpected decode %+v", v)
+	}
+	if len(v.Shocks) != 3 || v.Shocks[1] != 20 || v.FirstShocks != [2]float64{0, 20} {
+		t.Errorf("unexpected shocks %+v %+v", v.Shocks, v.FirstShocks)
+	}
+
+	var missing struct {
+		Gear int `irsdk:"Gear"`
+	}
+	if err := s.Decode(&missing); !errors.Is(err, ErrUnknownVar) {
+		t.Errorf("expected ErrUnknownVar got %v", err)
+	}
+	var wrong struct {
+		Lap bool `irsdk:"Lap"`
+	}
+	if err := s.Decode(&wrong); !errors.Is(err, ErrVarType) {
+		t.Errorf("expected ErrVarType got %v", err)
+	}
+	if err := s.Decode(v); err == nil {
+		t.Error("expected error decoding into a struct value")
+	}
+}
+
+func TestSampleDecodeTypes(t *testing.T) {
+	s := &Sample{varHeaders: map[string]*varHeader{}, buf: make([]byte, 16)}
+	for _, h := range []*varHeader{
+		{t: irchar, offset: 0, count: 1, name: "Char"},
+		{t: irbool, offset: 1, count: 2, name: "Bools"},
+		{t: irbitField, offset: 4, count: 1, name: "SessionFlags"},
+		{t: irint, offset: 8, count: 2, name: "CarIdxTrackSurface"},
+	} {
+		s.varHeaders[h.name] = h
+	}
+	s.buf[0], s.buf[2] = 'x', 1
+	le := binary.LittleEndian
+	le.PutUint32(s.buf[4:], uint32(FlagGreen|FlagBlue))
+	le.PutUint32(s.buf[8:], uint32(TrkLocOnTrack))
+	le.PutUint32(s.buf[12:], 0xffffffff)
+
+	var v struct {
+		Char     byte         `irsdk:"Char"`
+		CharInt  int          `irsdk:"Char"`
+		Bools    []bool       `irsdk:"Bools"`
+		Flags    SessionFlags `irsdk:"SessionFlags"`
+		Surface  []TrkLoc     `irsdk:"CarIdxTrackSurface"`
+		Surfaces [3]float64   `irsdk:"CarIdxTrackSurface"`
+	}
+	if err := s.Decode(&v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Char != 'x' || v.CharInt != 'x' || len(v.Bools) != 2 || v.Bools[0] || !v.Bools[1] || v.Flags != FlagGreen|FlagBlue {
+		t.Errorf("unexpected decode %+v", v)
+	}
+	if len(v.Surface) != 2 || v.Surface[0] != TrkLocOnTrack || v.Surface[1] != TrkLocNotInWorld || v.Surfaces != [3]float64{3, -1, 0} {
+		t.Errorf("unexpected surfaces %v %v", v.Surface, v.Surfaces)
+	}
+
+	var boolFromInt struct {
+		Flags bool `irsdk:"SessionFlags"`
+	}
+	var scalarFromArray struct {
+		Surface TrkLoc `irsdk:"CarIdxTrackSurface"`
+	}
+	var arrayFromScalar struct {
+		Flags []SessionFlags `irsdk:"SessionFlags"`
+	}
+	var stringFromChar struct {
+		Char string `irsdk:"Char"`
+	}
+	for _, dst := range []interface{}{&boolFromInt, &scalarFromArray, &arrayFromScalar, &stringFromChar} {
+		if err := s.Decode(dst); !errors.Is(err, ErrVarType) {
+			t.Errorf("decoding %T got %v want ErrVarType", dst, err)
+		}
+	}
+}
+
+// decodeBenchmark is a struct of scalar fields decoded each tick
+type decodeBenchmark struct {
+	SessionTime float64 `irsdk:"SessionTime"`
+	Lap         int     `irsdk:"Lap"`
+	Speed       float32 `irsdk:"Speed"`
+	Gear        int     `irsdk:"Gear,omitempty"`
+}
+
+func TestSampleDecodeAllocs(t *testing.T) {
+	s := newTestSample(1.5, 4, 20)
+	var v decodeBenchmark
+	if allocs := testing.AllocsPerRun(100, func() { s.Decode(&v) }); allocs != 0 {
+		t.Errorf("decoding scalars allocates %v times", allocs)
+	}
+}
+
+func BenchmarkSampleDecode(b *testing.B) {
+	s := newTestSample(1.5, 4, 20)
+	var v decodeBenchmark
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := s.Decode(&v); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
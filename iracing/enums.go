@@ -0,0 +1,385 @@
+package iracing
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// SessionFlags are the irsdk_Flags bits of the SessionFlags and CarIdxSessionFlags variables
+type SessionFlags uint32
+
+const (
+	FlagCheckered     SessionFlags = 0x00000001
+	FlagWhite         SessionFlags = 0x00000002
+	FlagGreen         SessionFlags = 0x00000004
+	FlagYellow        SessionFlags = 0x00000008
+	FlagRed           SessionFlags = 0x00000010
+	FlagBlue          SessionFlags = 0x00000020
+	FlagDebris        SessionFlags = 0x00000040
+	FlagCrossed       SessionFlags = 0x00000080
+	FlagYellowWaving  SessionFlags = 0x00000100
+	FlagOneLapToGreen SessionFlags = 0x00000200
+	FlagGreenHeld     SessionFlags = 0x00000400
+	FlagTenToGo       SessionFlags = 0x00000800
+	FlagFiveToGo      SessionFlags = 0x00001000
+	FlagRandomWaving  SessionFlags = 0x00002000
+	FlagCaution       SessionFlags = 0x00004000
+	FlagCautionWaving SessionFlags = 0x00008000
+
+	// driver black flags
+	FlagBlack      SessionFlags = 0x00010000
+	FlagDisqualify SessionFlags = 0x00020000
+	FlagServicible SessionFlags = 0x00040000 // car is allowed service (not a flag)
+	FlagFurled     SessionFlags = 0x00080000
+	FlagRepair     SessionFlags = 0x00100000
+
+	// start lights
+	FlagStartHidden SessionFlags = 0x10000000
+	FlagStartReady  SessionFlags = 0x20000000
+	FlagStartSet    SessionFlags = 0x40000000
+	FlagStartGo     SessionFlags = 0x80000000
+)
+
+var sessionFlagNames = []flagName{
+	{uint32(FlagCheckered), "checkered"},
+	{uint32(FlagWhite), "white"},
+	{uint32(FlagGreen), "green"},
+	{uint32(FlagYellow), "yellow"},
+	{uint32(FlagRed), "red"},
+	{uint32(FlagBlue), "blue"},
+	{uint32(FlagDebris), "debris"},
+	{uint32(FlagCrossed), "crossed"},
+	{uint32(FlagYellowWaving), "yellowWaving"},
+	{uint32(FlagOneLapToGreen), "oneLapToGreen"},
+	{uint32(FlagGreenHeld), "greenHeld"},
+	{uint32(FlagTenToGo), "tenToGo"},
+	{uint32(FlagFiveToGo), "fiveToGo"},
+	{uint32(FlagRandomWaving), "randomWaving"},
+	{uint32(FlagCaution), "caution"},
+	{uint32(FlagCautionWaving), "cautionWaving"},
+	{uint32(FlagBlack), "black"},
+	{uint32(FlagDisqualify), "disqualify"},
+	{uint32(FlagServicible), "servicible"},
+	{uint32(FlagFurled), "furled"},
+	{uint32(FlagRepair), "repair"},
+	{uint32(FlagStartHidden), "startHidden"},
+	{uint32(FlagStartReady), "startReady"},
+	{uint32(FlagStartSet), "startSet"},
+	{uint32(FlagStartGo), "startGo"},
+}
+
+// Has reports whether every flag in flags is set
+func (f SessionFlags) Has(flags SessionFlags) bool { return f&flags == flags }
+
+// Names returns the names of the set flags
+func (f SessionFlags) Names() []string { return flagNames(uint32(f), sessionFlagNames) }
+
+func (f SessionFlags) String() string { return flagString(uint32(f), sessionFlagNames) }
+
+// MarshalJSON encodes the flags as a list of names
+func (f SessionFlags) MarshalJSON() ([]byte, error) { return json.Marshal(f.Names()) }
+
+// EngineWarnings are the irsdk_EngineWarnings bits of the EngineWarnings variable
+type EngineWarnings uint32
+
+const (
+	WaterTempWarning    EngineWarnings = 0x01
+	FuelPressureWarning EngineWarnings = 0x02
+	OilPressureWarning  EngineWarnings = 0x04
+	EngineStalled       EngineWarnings = 0x08
+	PitSpeedLimiter     EngineWarnings = 0x10
+	RevLimiterActive    EngineWarnings = 0x20
+	OilTempWarning      EngineWarnings = 0x40
+)
+
+var engineWarningNames = []flagName{
+	{uint32(WaterTempWarning), "waterTempWarning"},
+	{uint32(FuelPressureWarning), "fuelPressureWarning"},
+	{uint32(OilPressureWarning), "oilPressureWarning"},
+	{uint32(EngineStalled), "engineStalled"},
+	{uint32(PitSpeedLimiter), "pitSpeedLimiter"},
+	{uint32(RevLimiterActive), "revLimiterActive"},
+	{uint32(OilTempWarning), "oilTempWarning"},
+}
+
+// Has reports whether every warning in warnings is set
+func (w EngineWarnings) Has(warnings EngineWarnings) bool { return w&warnings == warnings }
+
+// Names returns the names of the set warnings
+func (w EngineWarnings) Names() []string { return flagNames(uint32(w), engineWarningNames) }
+
+func (w EngineWarnings) String() string { return flagString(uint32(w), engineWarningNames) }
+
+// MarshalJSON encodes the warnings as a list of names
+func (w EngineWarnings) MarshalJSON() ([]byte, error) { return json.Marshal(w.Names()) }
+
+// CameraState are the irsdk_CameraState bits of the CamCameraState variable
+type CameraState uint32
+
+const (
+	CamIsSessionScreen       CameraState = 0x0001 // the camera tool can only be activated if viewing the session screen (out of car)
+	CamIsScenicActive        CameraState = 0x0002 // the scenic camera is active (no focus car)
+	CamToolActive            CameraState = 0x0004
+	CamUIHidden              CameraState = 0x0008
+	CamUseAutoShotSelection  CameraState = 0x0010
+	CamUseTemporaryEdits     CameraState = 0x0020
+	CamUseKeyAcceleration    CameraState = 0x0040
+	CamUseKey10xAcceleration CameraState = 0x0080
+	CamUseMouseAimMode       CameraState = 0x0100
+)
+
+var cameraStateNames = []flagName{
+	{uint32(CamIsSessionScreen), "isSessionScreen"},
+	{uint32(CamIsScenicActive), "isScenicActive"},
+	{uint32(CamToolActive), "camToolActive"},
+	{uint32(CamUIHidden), "uiHidden"},
+	{uint32(CamUseAutoShotSelection), "useAutoShotSelection"},
+	{uint32(CamUseTemporaryEdits), "useTemporaryEdits"},
+	{uint32(CamUseKeyAcceleration), "useKeyAcceleration"},
+	{uint32(CamUseKey10xAcceleration), "useKey10xAcceleration"},
+	{uint32(CamUseMouseAimMode), "useMouseAimMode"},
+}
+
+// Has reports whether every state bit in state is set
+func (c CameraState) Has(state CameraState) bool { return c&state == state }
+
+// Names returns the names of the set state bits
+func (c CameraState) Names() []string { return flagNames(uint32(c), cameraStateNames) }
+
+func (c CameraState) String() string { return flagString(uint32(c), cameraStateNames) }
+
+// MarshalJSON encodes the state as a list of names
+func (c CameraState) MarshalJSON() ([]byte, error) { return json.Marshal(c.Names()) }
+
+// PitSvFlags are the irsdk_PitSvFlags bits of the PitSvFlags variable
+type PitSvFlags uint32
+
+const (
+	PitSvLFTireChange      PitSvFlags = 0x0001
+	PitSvRFTireChange      PitSvFlags = 0x0002
+	PitSvLRTireChange      PitSvFlags = 0x0004
+	PitSvRRTireChange      PitSvFlags = 0x0008
+	PitSvFuelFill          PitSvFlags = 0x0010
+	PitSvWindshieldTearoff PitSvFlags = 0x0020
+	PitSvFastRepair        PitSvFlags = 0x0040
+)
+
+var pitSvFlagNames = []flagName{
+	{uint32(PitSvLFTireChange), "lfTireChange"},
+	{uint32(PitSvRFTireChange), "rfTireChange"},
+	{uint32(PitSvLRTireChange), "lrTireChange"},
+	{uint32(PitSvRRTireChange), "rrTireChange"},
+	{uint32(PitSvFuelFill), "fuelFill"},
+	{uint32(PitSvWindshieldTearoff), "windshieldTearoff"},
+	{uint32(PitSvFastRepair), "fastRepair"},
+}
+
+// Has reports whether every service in flags is requested
+func (p PitSvFlags) Has(flags PitSvFlags) bool { return p&flags == flags }
+
+// Names returns the names of the requested services
+func (p PitSvFlags) Names() []string { return flagNames(uint32(p), pitSvFlagNames) }
+
+func (p PitSvFlags) String() string { return flagString(uint32(p), pitSvFlagNames) }
+
+// MarshalJSON encodes the flags as a list of names
+func (p PitSvFlags) MarshalJSON() ([]byte, error) { return json.Marshal(p.Names()) }
+
+// PaceFlags are the irsdk_PaceFlags bits of the PaceFlags and CarIdxPaceFlags variables
+type PaceFlags uint32
+
+const (
+	PaceFlagsEndOfLine   PaceFlags = 0x01
+	PaceFlagsFreePass    PaceFlags = 0x02
+	PaceFlagsWavedAround PaceFlags = 0x04
+)
+
+var paceFlagNames = []flagName{
+	{uint32(PaceFlagsEndOfLine), "endOfLine"},
+	{uint32(PaceFlagsFreePass), "freePass"},
+	{uint32(PaceFlagsWavedAround), "wavedAround"},
+}
+
+// Has reports whether every flag in flags is set
+func (p PaceFlags) Has(flags PaceFlags) bool { return p&flags == flags }
+
+// Names returns the names of the set flags
+func (p PaceFlags) Names() []string { return flagNames(uint32(p), paceFlagNames) }
+
+func (p PaceFlags) String() string { return flagString(uint32(p), paceFlagNames) }
+
+// MarshalJSON encodes the flags as a list of names
+func (p PaceFlags) MarshalJSON() ([]byte, error) { return json.Marshal(p.Names()) }
+
+// SessionState is the irsdk_SessionState of the SessionState variable
+type SessionState int32
+
+const (
+	StateInvalid SessionState = iota
+	StateGetInCar
+	StateWarmup
+	StateParadeLaps
+	StateRacing
+	StateCheckered
+	StateCoolDown
+)
+
+var sessionStateNames = []string{"invalid", "getInCar", "warmup", "paradeLaps", "racing", "checkered", "coolDown"}
+
+func (s SessionState) String() string {
+	return enumString("SessionState", int32(s), 0, sessionStateNames)
+}
+
+// MarshalText encodes the state as its name
+func (s SessionState) MarshalText() ([]byte, error) { return []byte(s.String()), nil }
+
+// TrkLoc is the irsdk_TrkLoc track surface of the PlayerTrackSurface and CarIdxTrackSurface variables
+type TrkLoc int32
+
+const (
+	TrkLocNotInWorld      TrkLoc = -1
+	TrkLocOffTrack        TrkLoc = 0
+	TrkLocInPitStall      TrkLoc = 1
+	TrkLocApproachingPits TrkLoc = 2 // the sim spells it irsdk_AproachingPits
+	TrkLocOnTrack         TrkLoc = 3
+)
+
+var trkLocNames = []string{"notInWorld", "offTrack", "inPitStall", "approachingPits", "onTrack"}
+
+func (l TrkLoc) String() string { return enumString("TrkLoc", int32(l), -1, trkLocNames) }
+
+// MarshalText encodes the location as its name
+func (l TrkLoc) MarshalText() ([]byte, error) { return []byte(l.String()), nil }
+
+// PaceMode is the irsdk_PaceMode of the PaceMode variable
+type PaceMode int32
+
+const (
+	PaceModeSingleFileStart PaceMode = iota
+	PaceModeDoubleFileStart
+	PaceModeSingleFileRestart
+	PaceModeDoubleFileRestart
+	PaceModeNotPacing
+)
+
+var paceModeNames = []string{"singleFileStart", "doubleFileStart", "singleFileRestart", "doubleFileRestart", "notPacing"}
+
+func (m PaceMode) String() string { return enumString("PaceMode", int32(m), 0, paceModeNames) }
+
+// MarshalText encodes the mode as its name
+func (m PaceMode) MarshalText() ([]byte, error) { return []byte(m.String()), nil }
+
+// CarLeftRight is the irsdk_CarLeftRight spotter state of the CarLeftRight variable
+type CarLeftRight int32
+
+const (
+	LROff CarLeftRight = iota
+	LRClear
+	LRCarLeft
+	LRCarRight
+	LRCarLeftRight
+	LR2CarsLeft
+	LR2CarsRight
+)
+
+var carLeftRightNames = []string{"off", "clear", "carLeft", "carRight", "carLeftRight", "2CarsLeft", "2CarsRight"}
+
+func (lr CarLeftRight) String() string {
+	return enumString("CarLeftRight", int32(lr), 0, carLeftRightNames)
+}
+
+// MarshalText encodes the state as its name
+func (lr CarLeftRight) MarshalText() ([]byte, error) { return []byte(lr.String()), nil }
+
+// BitField is the value of an irsdk bitField variable without a bitfield type of its own.
+// Its bits have no names so they are named by their hex value e.g. 0x4.
+type BitField uint32
+
+// Has reports whether every bit in bits is set
+func (b BitField) Has(bits BitField) bool { return b&bits == bits }
+
+// Names returns the hex values of the set bits
+func (b BitField) Names() []string { return flagNames(uint32(b), nil) }
+
+func (b BitField) String() string { return flagString(uint32(b), nil) }
+
+// MarshalJSON encodes the bits as a list of their hex values
+func (b BitField) MarshalJSON() ([]byte, error) { return json.Marshal(b.Names()) }
+
+// bitFieldType is the type of bitField variables not in enumVars
+var bitFieldType = reflect.TypeOf(BitField(0))
+
+// enumVars are the types of the variables holding irsdk enums and bitfields.
+// Sample.Value converts the values of these variables to their type and the values of other
+// bitField variables to BitField.
+var enumVars = map[string]reflect.Type{
+	"SessionFlags":       reflect.TypeOf(SessionFlags(0)),
+	"CarIdxSessionFlags": reflect.TypeOf(SessionFlags(0)),
+	"EngineWarnings":     reflect.TypeOf(EngineWarnings(0)),
+	"CamCameraState":     reflect.TypeOf(CameraState(0)),
+	"PitSvFlags":         reflect.TypeOf(PitSvFlags(0)),
+	"PaceFlags":          reflect.TypeOf(PaceFlags(0)),
+	"CarIdxPaceFlags":    reflect.TypeOf(PaceFlags(0)),
+	"SessionState":       reflect.TypeOf(SessionState(0)),
+	"PlayerTrackSurface": reflect.TypeOf(TrkLoc(0)),
+	"CarIdxTrackSurface": reflect.TypeOf(TrkLoc(0)),
+	"PaceMode":           reflect.TypeOf(PaceMode(0)),
+	"CarLeftRight":       reflect.TypeOf(CarLeftRight(0)),
+}
+
+// enumValue converts the int32 or uint32 value v, or a slice of them, of an enum variable to the
+// enum type t
+func enumValue(v interface{}, t reflect.Type) interface{} {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return rv.Convert(t).Interface()
+	}
+	out := reflect.MakeSlice(reflect.SliceOf(t), rv.Len(), rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		out.Index(i).Set(rv.Index(i).Convert(t))
+	}
+	return out.Interface()
+}
+
+// flagName names a bit of an irsdk bitfield
+type flagName struct {
+	bit  uint32
+	name string
+}
+
+// flagNames returns the names of the bits set in v, unknown bits are named by their hex value
+func flagNames(v uint32, names []flagName) []string {
+	out := []string{}
+	for _, f := range names {
+		if v&f.bit != 0 {
+			out = append(out, f.name)
+			v &^= f.bit
+		}
+	}
+	for bit := uint32(1); v != 0; bit <<= 1 {
+		if v&bit != 0 {
+			out = append(out, fmt.Sprintf("0x%x", bit))
+			v &^= bit
+		}
+	}
+	return out
+}
+
+// flagString returns the names of the bits set in v separated by |
+func flagString(v uint32, names []flagName) string {
+	if v == 0 {
+		return "none"
+	}
+	return strings.Join(flagNames(v, names), "|")
+}
+
+// enumString returns the name of the enum value v where names starts at the value first.
+// Values without a name are formatted as type(v).
+func enumString(typeName string, v, first int32, names []string) string {
+	if i := v - first; i >= 0 && int(i) < len(names) {
+		return names[i]
+	}
+	return fmt.Sprintf("%s(%d)", typeName, v)
+}
@@ -0,0 +1,90 @@
+package iracing
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestEnumStrings(t *testing.T) {
+	flags := FlagGreen | FlagBlue | SessionFlags(0x01000000)
+	if !flags.Has(FlagGreen|FlagBlue) || flags.Has(FlagYellow) {
+		t.Errorf("unexpected Has for %v", flags)
+	}
+	if s := flags.String(); s != "green|blue|0x1000000" {
+		t.Errorf("got %q", s)
+	}
+	if s := SessionFlags(0).String(); s != "none" {
+		t.Errorf("got %q", s)
+	}
+	if s := TrkLocNotInWorld.String(); s != "notInWorld" {
+		t.Errorf("got %q", s)
+	}
+	if s := SessionState(9).String(); s != "SessionState(9)" {
+		t.Errorf("got %q", s)
+	}
+
+	b, err := json.Marshal(map[string]interface{}{
+		"flags":   flags,
+		"warn":    PitSpeedLimiter,
+		"state":   StateRacing,
+		"surface": []TrkLoc{TrkLocOnTrack, TrkLocInPitStall},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"flags":["green","blue","0x1000000"],"state":"racing","surface":["onTrack","inPitStall"],"warn":["pitSpeedLimiter"]}`
+	if string(b) != want {
+		t.Errorf("got %s want %s", b, want)
+	}
+}
+
+func TestSampleEnumValues(t *testing.T) {
+	buf := make([]byte, 28)
+	binary.LittleEndian.PutUint32(buf[0:], uint32(FlagCheckered))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(StateCheckered))
+	binary.LittleEndian.PutUint32(buf[8:], 3)
+	binary.LittleEndian.PutUint32(buf[12:], 0xffffffff)
+	binary.LittleEndian.PutUint32(buf[16:], 0x5)
+	binary.LittleEndian.PutUint32(buf[20:], 0x2)
+	binary.LittleEndian.PutUint32(buf[24:], 0)
+	s := &Sample{
+		varHeaders: map[string]*varHeader{
+			"SessionFlags":       {t: irbitField, offset: 0, count: 1, name: "SessionFlags"},
+			"SessionState":       {t: irint, offset: 4, count: 1, name: "SessionState"},
+			"CarIdxTrackSurface": {t: irint, offset: 8, count: 2, name: "CarIdxTrackSurface"},
+			"DcLapStatus":        {t: irbitField, offset: 16, count: 1, name: "DcLapStatus"},
+			"CarIdxStatus":       {t: irbitField, offset: 20, count: 2, name: "CarIdxStatus"},
+		},
+		buf: buf,
+	}
+	for name, want := range map[string]interface{}{
+		"SessionFlags":       FlagCheckered,
+		"SessionState":       StateCheckered,
+		"CarIdxTrackSurface": []TrkLoc{TrkLocOnTrack, TrkLocNotInWorld},
+		"DcLapStatus":        BitField(0x5),
+		"CarIdxStatus":       []BitField{0x2, 0},
+	} {
+		got, err := s.Value(name)
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("%s got %#v %v want %#v", name, got, err, want)
+		}
+	}
+}
+
+func TestBitField(t *testing.T) {
+	b := BitField(0x5)
+	if !b.Has(0x4) || !b.Has(0x5) || b.Has(0x2) {
+		t.Errorf("Has of %s", b)
+	}
+	if s := b.String(); s != "0x1|0x4" {
+		t.Errorf("got %s", s)
+	}
+	if s := BitField(0).String(); s != "none" {
+		t.Errorf("got %s for no bits", s)
+	}
+	if j, err := json.Marshal(b); err != nil || string(j) != `["0x1","0x4"]` {
+		t.Errorf("json got %s %v", j, err)
+	}
+}
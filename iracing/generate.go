@@ -0,0 +1,155 @@
+package iracing
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"reflect"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// GenerateConfig configures the Go file written by Generate
+type GenerateConfig struct {
+	Package string   // package of the generated file, defaults to telemetry
+	Type    string   // name of the generated struct, defaults to Telemetry
+	Vars    []string // names of the variables to generate, all variables if empty
+	Source  string   // where the variables were read from, noted in the file header
+}
+
+// goTypes are the Go types of the irsdk variable types
+var goTypes = map[string]string{
+	"char":     "byte",
+	"bool":     "bool",
+	"int":      "int32",
+	"bitField": "uint32",
+	"float":    "float32",
+	"double":   "float64",
+}
+
+// enumKinds are the kinds of the enum types each irsdk variable type may be generated as
+var enumKinds = map[string]reflect.Kind{
+	"int":      reflect.Int32,
+	"bitField": reflect.Uint32,
+}
+
+type generateField struct {
+	Name  string
+	Const string
+	Type  string
+	Var   VarInfo
+	Doc   string
+}
+
+var generateTemplate = template.Must(template.New("generate").Parse(`// Code generated by goiracing generate{{if .Source}} from {{.Source}}{{end}}. DO NOT EDIT.
+
+package {{.Package}}
+{{if .Import}}
+import "github.com/margic/goiracing/iracing"
+{{end}}
+// Names of the {{.Type}} variables
+const (
+{{- range .Fields}}
+	{{.Const}} = {{printf "%q" .Var.Name}}
+{{- end}}
+)
+
+// {{.Type}} holds the values of a telemetry sample, decode it with Decode on a client, sample or ibt reader
+type {{.Type}} struct {
+{{- range .Fields}}
+	// {{.Doc}}
+	{{.Name}} {{.Type}} ` + "`" + `irsdk:"{{.Var.Name}}"{{if .Var.Unit}} unit:"{{.Var.Unit}}"{{end}}` + "`" + `
+{{- end}}
+}
+`))
+
+// Generate writes a Go file with a struct of the variables in vars tagged for Decode, a constant for
+// each variable name, doc comments from the variable descriptions and unit annotations.
+func Generate(w io.Writer, vars []VarInfo, cfg *GenerateConfig) error {
+	c := GenerateConfig{Package: "telemetry", Type: "Telemetry"}
+	if cfg != nil {
+		c.Vars, c.Source = cfg.Vars, cfg.Source
+		if cfg.Package != "" {
+			c.Package = cfg.Package
+		}
+		if cfg.Type != "" {
+			c.Type = cfg.Type
+		}
+	}
+
+	selected := vars
+	if len(c.Vars) > 0 {
+		byName := make(map[string]VarInfo, len(vars))
+		for _, v := range vars {
+			byName[v.Name] = v
+		}
+		selected = make([]VarInfo, 0, len(c.Vars))
+		for _, name := range c.Vars {
+			v, ok := byName[name]
+			if !ok {
+				return fmt.Errorf("%w: %s", ErrUnknownVar, name)
+			}
+			selected = append(selected, v)
+		}
+	}
+
+	qualifier := "iracing."
+	if c.Package == "iracing" {
+		qualifier = ""
+	}
+	data := struct {
+		GenerateConfig
+		Import bool
+		Fields []generateField
+	}{GenerateConfig: c}
+	for _, v := range selected {
+		name := exportedName(v.Name)
+		f := generateField{Name: name, Const: "Var" + name, Var: v, Type: goTypes[v.Type]}
+		// the enum type is only used when it holds the irsdk type of the variable
+		if t, ok := enumVars[v.Name]; ok && enumKinds[v.Type] == t.Kind() {
+			f.Type = qualifier + t.Name()
+			data.Import = data.Import || qualifier != ""
+		}
+		if f.Type == "" {
+			return fmt.Errorf("%w: %s has unknown type %s", ErrVarType, v.Name, v.Type)
+		}
+		if v.Count > 1 {
+			f.Type = "[]" + f.Type
+		}
+		f.Doc = name
+		if desc := strings.Join(strings.Fields(v.Desc), " "); desc != "" {
+			f.Doc += " " + desc
+		}
+		if v.Unit != NoUnit {
+			f.Doc += " (" + string(v.Unit) + ")"
+		}
+		data.Fields = append(data.Fields, f)
+	}
+
+	var buf bytes.Buffer
+	if err := generateTemplate.Execute(&buf, data); err != nil {
+		return err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting generated code: %w", err)
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+// exportedName returns the variable name as an exported Go identifier, e.g. dcBrakeBias as DcBrakeBias
+func exportedName(varName string) string {
+	name := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			return r
+		}
+		return '_'
+	}, varName)
+	if name == "" || !unicode.IsLetter(rune(name[0])) {
+		return "V" + name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
@@ -0,0 +1,110 @@
+package iracing
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	vars := append(newTestSample(0, 0, 0).Vars(),
+		VarInfo{Name: "SessionFlags", Desc: "Session flags", Unit: "irsdk_Flags", Type: "bitField", Count: 1},
+		VarInfo{Name: "dcBrakeBias", Desc: "In car brake bias\n adjustment", Type: "float", Count: 1},
+	)
+	var buf bytes.Buffer
+	if err := Generate(&buf, vars, &GenerateConfig{Package: "car", Type: "Car"}); err != nil {
+		t.Fatal(err)
+	}
+	src := buf.String()
+	for _, want := range []string{
+		"package car",
+		`import "github.com/margic/goiracing/iracing"`,
+		`VarShockDefl    = "ShockDefl"`,
+		"type Car struct {",
+		"// Speed GPS vehicle speed (m/s)",
+		"Speed float32 `irsdk:\"Speed\" unit:\"m/s\"`",
+		"ShockDefl []float32",
+		"SessionFlags iracing.SessionFlags",
+		"// DcBrakeBias In car brake bias adjustment\n",
+		"DcBrakeBias float32 `irsdk:\"dcBrakeBias\"`",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("generated code missing %q\n%s", want, src)
+		}
+	}
+
+	if err := Generate(&buf, vars, &GenerateConfig{Vars: []string{"Nope"}}); !errors.Is(err, ErrUnknownVar) {
+		t.Errorf("expected ErrUnknownVar got %v", err)
+	}
+}
+
+func TestGenerateEnumTypes(t *testing.T) {
+	vars := []VarInfo{
+		{Name: "SessionFlags", Type: "bitField", Count: 1},
+		{Name: "CarIdxTrackSurface", Type: "int", Count: 64},
+		{Name: "PlayerTrackSurface", Type: "float", Count: 1},
+		{Name: "EngineWarnings", Type: "int", Count: 1},
+	}
+	var buf bytes.Buffer
+	if err := Generate(&buf, vars, nil); err != nil {
+		t.Fatal(err)
+	}
+	src := buf.String()
+	for _, want := range []string{
+		"SessionFlags iracing.SessionFlags",
+		"CarIdxTrackSurface []iracing.TrkLoc",
+		"PlayerTrackSurface float32",
+		"EngineWarnings int32",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("generated code missing %q\n%s", want, src)
+		}
+	}
+}
+
+// TestGenerateCompiles builds the generated code of a sample and of every enum variable
+func TestGenerateCompiles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds with the go command")
+	}
+	goCmd, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	vars := newTestSample(0, 0, 0).Vars()
+	for name, typ := range enumVars {
+		v := VarInfo{Name: name, Type: "int", Count: 1}
+		if typ.Kind() == reflect.Uint32 {
+			v.Type = "bitField"
+		}
+		if strings.HasPrefix(name, "CarIdx") {
+			v.Count = 64
+		}
+		vars = append(vars, v)
+	}
+	sort.Slice(vars, func(i, j int) bool { return vars[i].Name < vars[j].Name })
+
+	// the package is built inside the module so it can import this package
+	dir, err := ioutil.TempDir("testdata", "generate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	var buf bytes.Buffer
+	if err := Generate(&buf, vars, &GenerateConfig{Package: "car"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "telemetry.go"), buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if out, err := exec.Command(goCmd, "build", "./"+filepath.ToSlash(dir)).CombinedOutput(); err != nil {
+		t.Fatalf("building generated code: %v\n%s\n%s", err, out, buf.String())
+	}
+}
@@ -31,39 +31,37 @@ type IRHeader struct {
 
 const headerLength = 40 // number of bytes the iracing header consumes at start of mem mapped file
 
-func newHeader(irMemMapedFile uintptr) *IRHeader {
+const bufInfoOffset = 48 // buf infos follow the header and 2 ints of padding
+const bufInfoLength = 16 // tick count, buf offset and 2 ints of padding
 
-	// set up a slice using the windows pointer to mem map file
-	headerSlice := Mmap{}
-	h := headerSlice.Header()
-	h.Data = irMemMapedFile
-	h.Cap = headerLength
-	h.Len = headerLength
+// newHeader parses the iracing header from the start of the memory area b
+func newHeader(b []byte) (*IRHeader, error) {
+	if len(b) < headerLength {
+		return nil, fmt.Errorf("iracing header needs %d bytes have %d", headerLength, len(b))
+	}
 
 	header := &IRHeader{
-		Ver:                  int(binary.LittleEndian.Uint32(headerSlice[0:4])),
-		Status:               int(binary.LittleEndian.Uint32(headerSlice[4:8])),
-		TickRate:             int(binary.LittleEndian.Uint32(headerSlice[8:12])),
-		SessionInfoTickCount: int(binary.LittleEndian.Uint32(headerSlice[12:16])),
-		SessionInfoLen:       int(binary.LittleEndian.Uint32(headerSlice[16:20])),
-		SessionInfoOffset:    int(binary.LittleEndian.Uint32(headerSlice[20:24])),
-		NumVars:              int(binary.LittleEndian.Uint32(headerSlice[24:28])),
-		VarHeaderOffset:      int(binary.LittleEndian.Uint32(headerSlice[28:32])),
-		NumBuf:               int(binary.LittleEndian.Uint32(headerSlice[32:36])),
-		BufLen:               int(binary.LittleEndian.Uint32(headerSlice[36:40])),
+		Ver:                  int(binary.LittleEndian.Uint32(b[0:4])),
+		Status:               int(binary.LittleEndian.Uint32(b[4:8])),
+		TickRate:             int(binary.LittleEndian.Uint32(b[8:12])),
+		SessionInfoTickCount: int(binary.LittleEndian.Uint32(b[12:16])),
+		SessionInfoLen:       int(binary.LittleEndian.Uint32(b[16:20])),
+		SessionInfoOffset:    int(binary.LittleEndian.Uint32(b[20:24])),
+		NumVars:              int(binary.LittleEndian.Uint32(b[24:28])),
+		VarHeaderOffset:      int(binary.LittleEndian.Uint32(b[28:32])),
+		NumBuf:               int(binary.LittleEndian.Uint32(b[32:36])),
+		BufLen:               int(binary.LittleEndian.Uint32(b[36:40])),
 	}
 
-	bufInfoSlice := Mmap{}
-	h = bufInfoSlice.Header()
-	h.Data = irMemMapedFile + 48
-	h.Cap = header.NumBuf * header.BufLen
-	h.Len = header.NumBuf * header.BufLen
+	bufInfoEnd := bufInfoOffset + header.NumBuf*bufInfoLength
+	if header.NumBuf < 0 || len(b) < bufInfoEnd {
+		return nil, fmt.Errorf("iracing header buf infos for %d bufs exceed %d bytes", header.NumBuf, len(b))
+	}
+	bufInfoSlice := b[bufInfoOffset:bufInfoEnd]
 
-	fmt.Printf("bufCount %d\r\n", header.NumBuf)
 	bufInfos := make([]*BufInfo, header.NumBuf)
-	infoLen := 16
 	for i := 0; i < header.NumBuf; i++ {
-		s := i * infoLen
+		s := i * bufInfoLength
 		bufInfo := &BufInfo{
 			TickCount: int(binary.LittleEndian.Uint32(bufInfoSlice[s : s+4])),
 			BufOffset: int(binary.LittleEndian.Uint32(bufInfoSlice[s+4 : s+8])),
@@ -71,8 +69,28 @@ func newHeader(irMemMapedFile uintptr) *IRHeader {
 		bufInfos[i] = bufInfo
 	}
 	header.BufInfos = bufInfos
-	fmt.Printf("bufInfos %d\r\n", len(bufInfos))
-	return header
+	return header, nil
+}
+
+// bytes encodes the header and its buf infos in the layout read by newHeader.
+// Space is left for the 4 buf infos defined by the irsdk.
+func (header *IRHeader) bytes() []byte {
+	numBuf := len(header.BufInfos)
+	if numBuf < 4 {
+		numBuf = 4
+	}
+	b := make([]byte, bufInfoOffset+numBuf*bufInfoLength)
+	for i, v := range []int{header.Ver, header.Status, header.TickRate,
+		header.SessionInfoTickCount, header.SessionInfoLen, header.SessionInfoOffset,
+		header.NumVars, header.VarHeaderOffset, header.NumBuf, header.BufLen} {
+		binary.LittleEndian.PutUint32(b[i*4:(i+1)*4], uint32(v))
+	}
+	for i, bufInfo := range header.BufInfos {
+		s := bufInfoOffset + i*bufInfoLength
+		binary.LittleEndian.PutUint32(b[s:s+4], uint32(bufInfo.TickCount))
+		binary.LittleEndian.PutUint32(b[s+4:s+8], uint32(bufInfo.BufOffset))
+	}
+	return b
 }
 
 type BufInfo struct {
@@ -0,0 +1,232 @@
+package iracing
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ibtHeaderLength is the size of the irsdk header including padding and the 4 buf infos
+const ibtHeaderLength = bufInfoOffset + 4*bufInfoLength
+
+// diskSubHeaderLength is the size of the irsdk disk sub header which follows the header in an ibt file
+const diskSubHeaderLength = 32
+
+// DiskSubHeader represents the irsdk disk sub header of an ibt telemetry file.
+// It describes when the session was recorded and how many sample records the file holds.
+type DiskSubHeader struct {
+	SessionStartDate   time.Time // wall clock time the recording started
+	SessionStartTime   float64   // session time in seconds of the first record
+	SessionEndTime     float64   // session time in seconds of the last record
+	SessionLapCount    int
+	SessionRecordCount int // number of sample records in the file
+}
+
+func newDiskSubHeader(b []byte) *DiskSubHeader {
+	return &DiskSubHeader{
+		SessionStartDate:   time.Unix(int64(binary.LittleEndian.Uint64(b[0:8])), 0),
+		SessionStartTime:   math.Float64frombits(binary.LittleEndian.Uint64(b[8:16])),
+		SessionEndTime:     math.Float64frombits(binary.LittleEndian.Uint64(b[16:24])),
+		SessionLapCount:    int(binary.LittleEndian.Uint32(b[24:28])),
+		SessionRecordCount: int(binary.LittleEndian.Uint32(b[28:32])),
+	}
+}
+
+// bytes encodes the disk sub header in the layout read by newDiskSubHeader
+func (d *DiskSubHeader) bytes() []byte {
+	b := make([]byte, diskSubHeaderLength)
+	binary.LittleEndian.PutUint64(b[0:8], uint64(d.SessionStartDate.Unix()))
+	binary.LittleEndian.PutUint64(b[8:16], math.Float64bits(d.SessionStartTime))
+	binary.LittleEndian.PutUint64(b[16:24], math.Float64bits(d.SessionEndTime))
+	binary.LittleEndian.PutUint32(b[24:28], uint32(d.SessionLapCount))
+	binary.LittleEndian.PutUint32(b[28:32], uint32(d.SessionRecordCount))
+	return b
+}
+
+// IBTReader reads iracing .ibt disk telemetry files.
+// The file starts with the same header as the live memory mapped file followed by the disk sub header,
+// the variable headers, the session info yaml and then SessionRecordCount sample records of BufLen bytes.
+//
+//	r, err := OpenIBT("lap.ibt")
+//	for r.Next() {
+//		speed, err := r.Sample().Float("Speed")
+//	}
+//	err = r.Err()
+type IBTReader struct {
+	r               io.ReaderAt
+	closer          io.Closer
+	Header          *IRHeader
+	DiskHeader      *DiskSubHeader
+	SessionInfoYaml string
+	varHeaders      map[string]*varHeader
+	dataOffset      int64
+	record          int
+	sample          *Sample
+	err             error
+}
+
+// OpenIBT opens the ibt file at path. The reader must be closed when done.
+func OpenIBT(path string) (*IBTReader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	r, err := NewIBTReader(f)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("reading ibt file %s: %w", path, err)
+	}
+	r.closer = f
+	return r, nil
+}
+
+// NewIBTReader reads the headers, variable headers and session info of the ibt data in r.
+// r must have a Size method, like bytes.Reader and io.SectionReader, or a Stat method, like os.File,
+// so the sizes and offsets in the headers can be checked against the size of the data.
+func NewIBTReader(r io.ReaderAt) (*IBTReader, error) {
+	size, err := readerSize(r)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, ibtHeaderLength+diskSubHeaderLength)
+	if _, err := r.ReadAt(b, 0); err != nil {
+		return nil, fmt.Errorf("reading ibt header: %w", err)
+	}
+	header, err := newHeader(b[:ibtHeaderLength])
+	if err != nil {
+		return nil, err
+	}
+	if header.NumBuf < 1 {
+		return nil, fmt.Errorf("ibt header has no variable buffers")
+	}
+	if err := checkIBTRange("session info", header.SessionInfoOffset, header.SessionInfoLen, size); err != nil {
+		return nil, err
+	}
+	if header.NumVars < 0 || int64(header.NumVars) > size/int64(varHeaderLenth) {
+		return nil, fmt.Errorf("ibt header has %d variables, too many for %d bytes", header.NumVars, size)
+	}
+	if err := checkIBTRange("variable headers", header.VarHeaderOffset, varHeaderLenth*header.NumVars, size); err != nil {
+		return nil, err
+	}
+	ibt := &IBTReader{
+		r:          r,
+		Header:     header,
+		DiskHeader: newDiskSubHeader(b[ibtHeaderLength:]),
+		dataOffset: int64(header.BufInfos[0].BufOffset),
+	}
+	records := ibt.DiskHeader.SessionRecordCount
+	if header.BufLen < 0 || header.BufLen > 0 && records > int(size)/header.BufLen {
+		return nil, fmt.Errorf("ibt has %d records of %d bytes, too many for %d bytes", records, header.BufLen, size)
+	}
+	if err := checkIBTRange("records", header.BufInfos[0].BufOffset, header.BufLen*records, size); err != nil {
+		return nil, err
+	}
+
+	session := make([]byte, header.SessionInfoLen)
+	if _, err := r.ReadAt(session, int64(header.SessionInfoOffset)); err != nil {
+		return nil, fmt.Errorf("reading ibt session info: %w", err)
+	}
+	ibt.SessionInfoYaml = decodeSessionString(session)
+
+	varHeaderSlice := make([]byte, varHeaderLenth*header.NumVars)
+	if _, err := r.ReadAt(varHeaderSlice, int64(header.VarHeaderOffset)); err != nil {
+		return nil, fmt.Errorf("reading ibt variable headers: %w", err)
+	}
+	ibt.varHeaders = parseVarHeaders(varHeaderSlice, header.NumVars)
+	for _, h := range ibt.varHeaders {
+		if !h.t.valid() {
+			return nil, fmt.Errorf("ibt variable %s has unknown type %d", h.name, int(h.t))
+		}
+		if h.offset < 0 || h.count < 0 || h.offset+h.length() > header.BufLen {
+			return nil, fmt.Errorf("ibt variable %s at %d with %d values outside of buffer length %d", h.name, h.offset, h.count, header.BufLen)
+		}
+	}
+
+	ibt.sample = &Sample{
+		varHeaders: ibt.varHeaders,
+		buf:        make([]byte, header.BufLen),
+	}
+	return ibt, nil
+}
+
+// readerSize returns the size of the data of r
+func readerSize(r io.ReaderAt) (int64, error) {
+	switch s := r.(type) {
+	case interface{ Size() int64 }:
+		return s.Size(), nil
+	case interface{ Stat() (os.FileInfo, error) }:
+		fi, err := s.Stat()
+		if err != nil {
+			return 0, fmt.Errorf("reading ibt size: %w", err)
+		}
+		return fi.Size(), nil
+	}
+	return 0, fmt.Errorf("ibt reader %T has no size", r)
+}
+
+// checkIBTRange checks the length bytes at offset are within the size of an ibt file
+func checkIBTRange(name string, offset, length int, size int64) error {
+	if offset < 0 || length < 0 || int64(offset) > size || int64(length) > size-int64(offset) {
+		return fmt.Errorf("ibt %s at %d with length %d outside of file size %d", name, offset, length, size)
+	}
+	return nil
+}
+
+// SessionInfo parses the session info of the file into go types.
+func (ibt *IBTReader) SessionInfo() (*Session, error) {
+	return ParseSessionInfo(ibt.SessionInfoYaml)
+}
+
+// Markers returns the markers added to the recording with IBTWriter.AddMarker, or none for files
+// recorded without markers or by the sim.
+func (ibt *IBTReader) Markers() ([]Marker, error) {
+	var m ibtMarkers
+	if err := yaml.Unmarshal([]byte(sanitizeSessionYaml(ibt.SessionInfoYaml)), &m); err != nil {
+		return nil, fmt.Errorf("reading ibt markers: %w", err)
+	}
+	return m.Markers, nil
+}
+
+// Next reads the next sample record. It returns false when there are no more records or
+// an error occurred, Err reports which.
+func (ibt *IBTReader) Next() bool {
+	if ibt.err != nil || ibt.record >= ibt.DiskHeader.SessionRecordCount {
+		return false
+	}
+	offset := ibt.dataOffset + int64(ibt.record)*int64(ibt.Header.BufLen)
+	if _, err := ibt.r.ReadAt(ibt.sample.buf, offset); err != nil {
+		ibt.err = fmt.Errorf("reading ibt record %d: %w", ibt.record, err)
+		return false
+	}
+	ibt.record++
+	return true
+}
+
+// Sample returns the record read by the last call to Next.
+// The sample is only valid until the next call to Next.
+func (ibt *IBTReader) Sample() *Sample {
+	return ibt.sample
+}
+
+// Record returns the index of the record read by the last call to Next.
+func (ibt *IBTReader) Record() int {
+	return ibt.record - 1
+}
+
+// Err returns the error, if any, that stopped Next.
+func (ibt *IBTReader) Err() error {
+	return ibt.err
+}
+
+// Close closes the file opened by OpenIBT.
+func (ibt *IBTReader) Close() error {
+	if ibt.closer == nil {
+		return nil
+	}
+	return ibt.closer.Close()
+}
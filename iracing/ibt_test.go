@@ -0,0 +1,137 @@
+package iracing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+// newTestIBT lays out an ibt file with one float variable per name and a record per value row
+func newTestIBT(session string, names []string, rows [][]float32) []byte {
+	varHeaderOffset := ibtHeaderLength + diskSubHeaderLength
+	sessionOffset := varHeaderOffset + varHeaderLenth*len(names)
+	dataOffset := sessionOffset + len(session)
+	bufLen := 4 * len(names)
+
+	b := make([]byte, dataOffset+len(rows)*bufLen)
+	le := binary.LittleEndian
+	le.PutUint32(b[0:4], 2)
+	le.PutUint32(b[8:12], 60)
+	le.PutUint32(b[16:20], uint32(len(session)))
+	le.PutUint32(b[20:24], uint32(sessionOffset))
+	le.PutUint32(b[24:28], uint32(len(names)))
+	le.PutUint32(b[28:32], uint32(varHeaderOffset))
+	le.PutUint32(b[32:36], 1)
+	le.PutUint32(b[36:40], uint32(bufLen))
+	le.PutUint32(b[bufInfoOffset+4:], uint32(dataOffset))
+
+	d := b[ibtHeaderLength:]
+	le.PutUint64(d[0:8], 1625097600)
+	le.PutUint64(d[16:24], math.Float64bits(12.5))
+	le.PutUint32(d[24:28], 3)
+	le.PutUint32(d[28:32], uint32(len(rows)))
+
+	for i, name := range names {
+		h := b[varHeaderOffset+i*varHeaderLenth:]
+		le.PutUint32(h[0:4], uint32(irfloat))
+		le.PutUint32(h[4:8], uint32(i*4))
+		le.PutUint32(h[8:12], 1)
+		copy(h[16:48], name)
+	}
+	copy(b[sessionOffset:], session)
+	for r, row := range rows {
+		for i, v := range row {
+			le.PutUint32(b[dataOffset+r*bufLen+i*4:], math.Float32bits(v))
+		}
+	}
+	return b
+}
+
+func TestIBTReader(t *testing.T) {
+	session := "---\nWeekendInfo:\n TrackName: spa\n"
+	rows := [][]float32{{1, 2}, {3, 4}, {5, 6}}
+	r, err := NewIBTReader(bytes.NewReader(newTestIBT(session, []string{"Speed", "RPM"}, rows)))
+	if err != nil {
+		t.Fatalf("NewIBTReader: %v", err)
+	}
+	if r.SessionInfoYaml != session {
+		t.Errorf("session info %q want %q", r.SessionInfoYaml, session)
+	}
+	if r.DiskHeader.SessionRecordCount != 3 || r.DiskHeader.SessionLapCount != 3 || r.DiskHeader.SessionEndTime != 12.5 {
+		t.Errorf("unexpected disk sub header %+v", r.DiskHeader)
+	}
+	if r.DiskHeader.SessionStartDate.Unix() != 1625097600 {
+		t.Errorf("start date %v", r.DiskHeader.SessionStartDate)
+	}
+
+	n := 0
+	for r.Next() {
+		speed, _ := r.Sample().Float("Speed")
+		rpm, _ := r.Sample().Float("RPM")
+		if speed != rows[n][0] || rpm != rows[n][1] {
+			t.Errorf("record %d got %v %v want %v", n, speed, rpm, rows[n])
+		}
+		n++
+	}
+	if err := r.Err(); err != nil {
+		t.Fatalf("Err: %v", err)
+	}
+	if n != len(rows) {
+		t.Errorf("read %d records want %d", n, len(rows))
+	}
+}
+
+func TestIBTReaderCorrupt(t *testing.T) {
+	session := "---\nWeekendInfo:\n TrackName: spa\n"
+	valid := newTestIBT(session, []string{"Speed", "RPM"}, [][]float32{{1, 2}})
+	varHeaderOffset := ibtHeaderLength + diskSubHeaderLength
+	le := binary.LittleEndian
+	tests := []struct {
+		name    string
+		corrupt func(b []byte) []byte
+	}{
+		{"truncated header", func(b []byte) []byte { return b[:ibtHeaderLength] }},
+		{"truncated variable headers", func(b []byte) []byte { return b[:varHeaderOffset+varHeaderLenth] }},
+		{"truncated record", func(b []byte) []byte { return b[:len(b)-1] }},
+		{"session info length", func(b []byte) []byte { le.PutUint32(b[16:20], math.MaxUint32); return b }},
+		{"session info offset", func(b []byte) []byte { le.PutUint32(b[20:24], uint32(len(b))); return b }},
+		{"variable count", func(b []byte) []byte { le.PutUint32(b[24:28], 1<<30); return b }},
+		{"buffer length", func(b []byte) []byte { le.PutUint32(b[36:40], math.MaxUint32); return b }},
+		{"record count", func(b []byte) []byte { le.PutUint32(b[ibtHeaderLength+28:], 2); return b }},
+		{"variable type", func(b []byte) []byte { le.PutUint32(b[varHeaderOffset:], 6); return b }},
+		{"variable offset", func(b []byte) []byte { le.PutUint32(b[varHeaderOffset+4:], 8); return b }},
+		{"variable count of values", func(b []byte) []byte { le.PutUint32(b[varHeaderOffset+8:], math.MaxUint32); return b }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := tt.corrupt(append([]byte(nil), valid...))
+			if _, err := NewIBTReader(bytes.NewReader(b)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestIBTReaderNoRecords(t *testing.T) {
+	r, err := NewIBTReader(bytes.NewReader(newTestIBT("---\n", []string{"Speed"}, nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Next() || r.Err() != nil {
+		t.Errorf("read a record from a file without records %v", r.Err())
+	}
+}
+
+func TestVarTypeUnknown(t *testing.T) {
+	if got := varType(6).size(); got != 0 {
+		t.Errorf("size %d want 0", got)
+	}
+	if got := varType(6).String(); got != "unknown(6)" {
+		t.Errorf("got %s want unknown(6)", got)
+	}
+	h := &varHeader{t: varType(-1), count: 3}
+	if got := h.length(); got != 0 {
+		t.Errorf("length %d want 0", got)
+	}
+}
@@ -0,0 +1,249 @@
+package iracing
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// IBTWriterConfig configures the contents of an ibt file written by IBTWriter
+type IBTWriterConfig struct {
+	TickRate        int      // samples per second, defaults to 60
+	SessionInfoYaml string   // session info written to the file ISO-8859-1 encoded
+	Vars            []string // names of the variables to record, all variables in the sample if empty
+}
+
+// IBTWriter records telemetry samples into an iracing .ibt disk telemetry file.
+// The headers are written when the writer is created, samples are appended with WriteSample
+// and Close patches the record count, lap count and end time into the disk sub header.
+type IBTWriter struct {
+	w           io.WriteSeeker
+	closer      io.Closer
+	header      *IRHeader
+	diskHeader  *DiskSubHeader
+	varHeaders  []*varHeader // headers of the recorded variables with their offsets in the file
+	buf         []byte
+	sessionInfo string   // session info yaml written with the headers
+	markers     []Marker // markers added with AddMarker
+	closed      bool
+}
+
+// CreateIBT creates the ibt file at path and writes the headers for the variables selected by cfg
+// from the variable headers of s.
+func CreateIBT(path string, s *Sample, cfg *IBTWriterConfig) (*IBTWriter, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	w, err := NewIBTWriter(f, s, cfg)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("creating ibt file %s: %w", path, err)
+	}
+	w.closer = f
+	return w, nil
+}
+
+// NewIBTWriter writes the headers for the variables selected by cfg from the variable headers of s to w.
+func NewIBTWriter(w io.WriteSeeker, s *Sample, cfg *IBTWriterConfig) (*IBTWriter, error) {
+	srcHeaders, err := selectVarHeaders(s, cfg.Vars)
+	if err != nil {
+		return nil, err
+	}
+
+	// lay out the selected variables one after the other in the record
+	varHeaders := make([]*varHeader, len(srcHeaders))
+	bufLen := 0
+	for i, src := range srcHeaders {
+		h := *src
+		h.offset = bufLen
+		bufLen += h.length()
+		varHeaders[i] = &h
+	}
+
+	tickRate := cfg.TickRate
+	if tickRate <= 0 {
+		tickRate = 60
+	}
+	varHeaderOffset := ibtHeaderLength + diskSubHeaderLength
+	sessionInfoOffset := varHeaderOffset + len(varHeaders)*varHeaderLenth
+	sessionInfo := append(encodeSessionString(cfg.SessionInfoYaml), 0) // include the nul terminator
+	sessionInfoLen := len(sessionInfo)
+	dataOffset := sessionInfoOffset + sessionInfoLen
+
+	ibt := &IBTWriter{
+		w: w,
+		header: &IRHeader{
+			Ver:                  2,
+			Status:               1,
+			TickRate:             tickRate,
+			SessionInfoTickCount: 1,
+			SessionInfoLen:       sessionInfoLen,
+			SessionInfoOffset:    sessionInfoOffset,
+			NumVars:              len(varHeaders),
+			VarHeaderOffset:      varHeaderOffset,
+			NumBuf:               1,
+			BufLen:               bufLen,
+			BufInfos:             []*BufInfo{{BufOffset: dataOffset}},
+		},
+		diskHeader: &DiskSubHeader{
+			SessionStartDate: time.Now(),
+		},
+		varHeaders:  varHeaders,
+		buf:         make([]byte, bufLen),
+		sessionInfo: cfg.SessionInfoYaml,
+	}
+
+	if _, err := w.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	if err := ibt.writeHeaders(); err != nil {
+		return nil, err
+	}
+	for _, h := range varHeaders {
+		if _, err := w.Write(h.bytes()); err != nil {
+			return nil, fmt.Errorf("writing variable header %s: %w", h.name, err)
+		}
+	}
+	if _, err := w.Write(sessionInfo); err != nil {
+		return nil, fmt.Errorf("writing session info: %w", err)
+	}
+	return ibt, nil
+}
+
+// selectVarHeaders returns the headers of the named variables in s, or all of them ordered by offset
+func selectVarHeaders(s *Sample, names []string) ([]*varHeader, error) {
+	var headers []*varHeader
+	if len(names) == 0 {
+		for _, h := range s.varHeaders {
+			headers = append(headers, h)
+		}
+		sort.Slice(headers, func(i, j int) bool { return headers[i].offset < headers[j].offset })
+		return headers, nil
+	}
+	for _, name := range names {
+		h := s.varHeaders[name]
+		if h == nil {
+			return nil, fmt.Errorf("unknown variable %s", name)
+		}
+		headers = append(headers, h)
+	}
+	return headers, nil
+}
+
+// writeHeaders writes the header and disk sub header at the current position of the writer
+func (ibt *IBTWriter) writeHeaders() error {
+	if _, err := ibt.w.Write(ibt.header.bytes()); err != nil {
+		return fmt.Errorf("writing ibt header: %w", err)
+	}
+	if _, err := ibt.w.Write(ibt.diskHeader.bytes()); err != nil {
+		return fmt.Errorf("writing ibt disk sub header: %w", err)
+	}
+	return nil
+}
+
+// WriteSample appends the recorded variables of s as a new record.
+// s must have the variables selected when the writer was created.
+func (ibt *IBTWriter) WriteSample(s *Sample) error {
+	if ibt.closed {
+		return fmt.Errorf("ibt writer closed")
+	}
+	for _, h := range ibt.varHeaders {
+		raw := s.raw(h.name)
+		if len(raw) != h.length() {
+			return fmt.Errorf("sample variable %s does not match recorded variable", h.name)
+		}
+		copy(ibt.buf[h.offset:], raw)
+	}
+	if _, err := ibt.w.Write(ibt.buf); err != nil {
+		return fmt.Errorf("writing ibt record %d: %w", ibt.diskHeader.SessionRecordCount, err)
+	}
+
+	// keep the disk sub header up to date with the session time and laps of the recorded samples
+	if sessionTime, err := s.Double("SessionTime"); err == nil {
+		if ibt.diskHeader.SessionRecordCount == 0 {
+			ibt.diskHeader.SessionStartTime = sessionTime
+		}
+		ibt.diskHeader.SessionEndTime = sessionTime
+	}
+	if lap, err := s.Int("Lap"); err == nil && int(lap) > ibt.diskHeader.SessionLapCount {
+		ibt.diskHeader.SessionLapCount = int(lap)
+	}
+	ibt.diskHeader.SessionRecordCount++
+	ibt.header.BufInfos[0].TickCount = ibt.diskHeader.SessionRecordCount
+	return nil
+}
+
+// AddMarker adds a named marker to the recording. The markers are written into the session info
+// of the file when the writer is closed and are read back with IBTReader.Markers.
+func (ibt *IBTWriter) AddMarker(m Marker) {
+	ibt.markers = append(ibt.markers, m)
+}
+
+// Close patches the headers with the record count, lap count and end time of the recorded samples.
+// The session info is rewritten after the records with the markers added to it if there are any.
+// The file is closed if the writer was created by CreateIBT.
+func (ibt *IBTWriter) Close() error {
+	if ibt.closed {
+		return nil
+	}
+	ibt.closed = true
+	var err error
+	if len(ibt.markers) > 0 {
+		err = ibt.writeMarkers()
+	}
+	if err == nil {
+		_, err = ibt.w.Seek(0, io.SeekStart)
+	}
+	if err == nil {
+		err = ibt.writeHeaders()
+	}
+	if ibt.closer != nil {
+		if cerr := ibt.closer.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// writeMarkers writes the session info with the markers added to it after the records and points
+// the header at it, the session info written with the headers is left unused
+func (ibt *IBTWriter) writeMarkers() error {
+	b, err := yaml.Marshal(ibtMarkers{Markers: ibt.markers})
+	if err != nil {
+		return fmt.Errorf("encoding ibt markers: %w", err)
+	}
+	// add the markers inside the session info document which the sim ends with ...
+	session := ibt.sessionInfo
+	docEnd := strings.HasSuffix(session, "...\n")
+	session = strings.TrimSuffix(session, "...\n")
+	if session != "" && !strings.HasSuffix(session, "\n") {
+		session += "\n"
+	}
+	session += string(b)
+	if docEnd {
+		session += "...\n"
+	}
+	sessionInfo := append(encodeSessionString(session), 0)
+
+	offset := ibt.header.BufInfos[0].BufOffset + ibt.diskHeader.SessionRecordCount*ibt.header.BufLen
+	if _, err := ibt.w.Seek(int64(offset), io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := ibt.w.Write(sessionInfo); err != nil {
+		return fmt.Errorf("writing ibt markers: %w", err)
+	}
+	ibt.header.SessionInfoOffset = offset
+	ibt.header.SessionInfoLen = len(sessionInfo)
+	return nil
+}
+
+// ibtMarkers is the Markers section written into the session info of an ibt file
+type ibtMarkers struct {
+	Markers []Marker `yaml:"Markers"`
+}
@@ -0,0 +1,169 @@
+package iracing
+
+import (
+	"encoding/binary"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newTestSample returns a sample with a double SessionTime, an int Lap, a float Speed
+// and a 3 value float array ShockDefl
+func newTestSample(sessionTime float64, lap int, speed float32) *Sample {
+	headers := []*varHeader{
+		{t: irdouble, offset: 0, count: 1, name: "SessionTime", unit: "s"},
+		{t: irint, offset: 8, count: 1, name: "Lap"},
+		{t: irfloat, offset: 12, count: 1, name: "Speed", desc: "GPS vehicle speed", unit: "m/s"},
+		{t: irfloat, offset: 16, count: 3, name: "ShockDefl", unit: "m"},
+	}
+	s := &Sample{varHeaders: map[string]*varHeader{}, buf: make([]byte, 28)}
+	for _, h := range headers {
+		s.varHeaders[h.name] = h
+	}
+	le := binary.LittleEndian
+	le.PutUint64(s.buf[0:8], math.Float64bits(sessionTime))
+	le.PutUint32(s.buf[8:12], uint32(lap))
+	le.PutUint32(s.buf[12:16], math.Float32bits(speed))
+	for i := 0; i < 3; i++ {
+		le.PutUint32(s.buf[16+i*4:], math.Float32bits(speed*float32(i)))
+	}
+	return s
+}
+
+func TestIBTWriterRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "roundtrip.ibt")
+	session := "---\nWeekendInfo:\n TrackName: spa\n"
+	w, err := CreateIBT(path, newTestSample(0, 0, 0), &IBTWriterConfig{
+		SessionInfoYaml: session,
+		Vars:            []string{"Speed", "ShockDefl", "SessionTime", "Lap"},
+	})
+	if err != nil {
+		t.Fatalf("CreateIBT: %v", err)
+	}
+	speeds := []float32{10, 20.5, 31}
+	for i, speed := range speeds {
+		if err := w.WriteSample(newTestSample(100+float64(i)/60, i+1, speed)); err != nil {
+			t.Fatalf("WriteSample: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	r, err := OpenIBT(path)
+	if err != nil {
+		t.Fatalf("OpenIBT: %v", err)
+	}
+	defer r.Close()
+
+	if r.SessionInfoYaml != session {
+		t.Errorf("session info %q want %q", r.SessionInfoYaml, session)
+	}
+	if r.Header.TickRate != 60 || r.Header.NumVars != 4 || r.Header.BufLen != 28 {
+		t.Errorf("unexpected header %+v", r.Header)
+	}
+	d := r.DiskHeader
+	if d.SessionRecordCount != 3 || d.SessionLapCount != 3 || d.SessionStartTime != 100 || d.SessionEndTime != 100+2.0/60 {
+		t.Errorf("unexpected disk sub header %+v", d)
+	}
+	if h := r.varHeaders["Speed"]; h == nil || h.offset != 0 || h.desc != "GPS vehicle speed" || h.unit != "m/s" {
+		t.Errorf("unexpected Speed header %+v", h)
+	}
+
+	n := 0
+	for r.Next() {
+		want := newTestSample(100+float64(n)/60, n+1, speeds[n])
+		for _, name := range []string{"SessionTime", "Lap", "Speed", "ShockDefl"} {
+			if got := r.Sample().raw(name); string(got) != string(want.raw(name)) {
+				t.Errorf("record %d %s got %v want %v", n, name, got, want.raw(name))
+			}
+		}
+		n++
+	}
+	if err := r.Err(); err != nil {
+		t.Fatalf("Err: %v", err)
+	}
+	if n != len(speeds) {
+		t.Errorf("read %d records want %d", n, len(speeds))
+	}
+}
+
+func TestIBTWriterMarkers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "markers.ibt")
+	session := "---\nWeekendInfo:\n TrackName: spa\n...\n"
+	w, err := CreateIBT(path, newTestSample(0, 0, 0), &IBTWriterConfig{SessionInfoYaml: session})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if err := w.WriteSample(newTestSample(float64(i), 1, float32(i))); err != nil {
+			t.Fatal(err)
+		}
+		if i == 1 {
+			w.AddMarker(Marker{Name: "apex", Time: time.Unix(1700000000, 0).UTC(), TickCount: 42, SessionTime: 1})
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := OpenIBT(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	markers, err := r.Markers()
+	if err != nil || len(markers) != 1 {
+		t.Fatalf("markers %+v %v", markers, err)
+	}
+	if m := markers[0]; m.Name != "apex" || !m.Time.Equal(time.Unix(1700000000, 0)) || m.TickCount != 42 || m.SessionTime != 1 {
+		t.Errorf("marker %+v", m)
+	}
+	if info, err := r.SessionInfo(); err != nil || info.WeekendInfo.TrackName != "spa" {
+		t.Errorf("session info with markers %q %v", r.SessionInfoYaml, err)
+	}
+	n := 0
+	for ; r.Next(); n++ {
+		if v, _ := r.Sample().Float("Speed"); v != float32(n) {
+			t.Errorf("record %d speed %v", n, v)
+		}
+	}
+	if err := r.Err(); err != nil || n != 3 {
+		t.Errorf("read %d records %v", n, err)
+	}
+}
+
+func TestIBTWriterNoMarkers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "none.ibt")
+	w, err := CreateIBT(path, newTestSample(0, 0, 0), &IBTWriterConfig{SessionInfoYaml: "---\n"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteSample(newTestSample(0, 1, 10)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	r, err := OpenIBT(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	if markers, err := r.Markers(); err != nil || markers != nil {
+		t.Errorf("got markers %+v %v for a recording without markers", markers, err)
+	}
+}
+
+func TestIBTWriterUnknownVar(t *testing.T) {
+	f, err := os.Create(filepath.Join(t.TempDir(), "unknown.ibt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := NewIBTWriter(f, newTestSample(0, 0, 0), &IBTWriterConfig{Vars: []string{"Nope"}}); err == nil {
+		t.Error("expected error for unknown variable")
+	}
+}
@@ -1,19 +1,19 @@
 package iracing
 
 import (
-	"fmt"
 	"testing"
-
-	"golang.org/x/sys/windows"
 )
 
 func TestNulTerminatedString(t *testing.T) {
 	b := []byte{97, 98, 99, 0} // abc nul terminated string
-	s := windows.ByteSliceToString(b)
+	s := nulTerminatedString(b)
 
-	if len(s) != 3 {
+	if s != "abc" {
 		t.Fail()
 	}
-	fmt.Println(string(b))
 
+	// a full field without a nul terminator is the whole field
+	if nulTerminatedString([]byte{97, 98, 99}) != "abc" {
+		t.Fail()
+	}
 }
@@ -0,0 +1,145 @@
+package iracing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// pollInterval is how often WaitForData re-reads the header while waiting for new data
+const pollInterval = time.Millisecond
+
+// nextDataTimeout is how long nextData waits for data before checking for cancellation
+const nextDataTimeout = 100 * time.Millisecond
+
+// DataUpdate describes the new data read by WaitForData
+type DataUpdate struct {
+	TickCount          int            // tick count of the variable buffer read
+	MissedTicks        int            // ticks written by the sim since the previous update that were not read
+	SessionInfoChanged bool           // session info was re-read since the previous update
+	VarHeadersChanged  bool           // variable headers were re-read because they changed since the previous update
+	SessionEvents      []SessionEvent // changes found in the session info and session number since the previous update
+}
+
+// WaitForData waits up to timeout for the sim to write a variable buffer newer than the last one read,
+// re-reading the header each poll. Mirroring irsdk waitForDataReady it reads the newest buffer, re-reads
+// session info when SessionInfoTickCount changes and the variable headers when they change.
+// Returns a nil update if no new data was written before the timeout.
+//
+// While the sim is not running WaitForData attempts to attach to it following the client backoff,
+// when the sim exits the client detaches so that it re-attaches when the sim restarts.
+func (ir *Client) WaitForData(timeout time.Duration) (*DataUpdate, error) {
+	deadline := time.Now().Add(timeout)
+	for !ir.stop {
+		if ir.status == closed {
+			if err := ir.tryOpen(); err != nil {
+				return nil, err
+			}
+		}
+		if ir.status != closed {
+			update, err := ir.getNewData()
+			if err != nil || update != nil {
+				return update, err
+			}
+		}
+		if !time.Now().Before(deadline) {
+			return nil, nil
+		}
+		time.Sleep(pollInterval)
+	}
+	return nil, fmt.Errorf("client stopped")
+}
+
+// nextData waits for new data until ctx is cancelled. Returns the ctx error once cancelled.
+// Buffers torn by the sim writing while they are copied are skipped.
+func (ir *Client) nextData(ctx context.Context) (*DataUpdate, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		update, err := ir.WaitForData(nextDataTimeout)
+		if errors.Is(err, errSnapshotTorn) {
+			ir.logger.Debug("skipping torn variable buffer")
+			continue
+		}
+		if err != nil || update != nil {
+			return update, err
+		}
+	}
+}
+
+// getNewData re-reads the header and reads the newest variable buffer if its tick count differs
+// from the last buffer read. Returns nil if there is no new data.
+func (ir *Client) getNewData() (*DataUpdate, error) {
+	prev := ir.header
+	if err := ir.readHeader(); err != nil {
+		return nil, err
+	}
+	if ir.header.Status&irsdkStConnected == 0 {
+		ir.logger.Info("iracing disconnected")
+		ir.detach()
+		ir.setState(Disconnected)
+		return nil, nil
+	}
+	if ir.status < loadedVarHeaders || varLayoutChanged(prev, ir.header) || ir.varHeadersModified() {
+		if err := ir.readVarHeaders(); err != nil {
+			return nil, err
+		}
+		ir.varHeadersChanged = true
+	}
+	if len(ir.header.BufInfos) == 0 {
+		return nil, fmt.Errorf("iracing header has no variable buffers")
+	}
+
+	lastTick := ir.varBufTickCount
+	tick := ir.bufTickCount(ir.newestBuf())
+	if ir.status == loadedVarBuf && tick == lastTick && !ir.varHeadersChanged {
+		if ir.State() == Connected && time.Since(ir.lastData) > ir.staleTimeout {
+			ir.setState(Stale)
+		}
+		return nil, nil
+	}
+	if err := ir.readVarBuf(); err != nil {
+		return nil, err
+	}
+	ir.checkSessionNum()
+
+	ir.eventLock.Lock()
+	update := &DataUpdate{
+		TickCount:          ir.varBufTickCount,
+		SessionInfoChanged: ir.sessionInfoTickCount != ir.updateSessionTick,
+		VarHeadersChanged:  ir.varHeadersChanged,
+		SessionEvents:      ir.pendingEvents,
+	}
+	ir.pendingEvents = nil
+	ir.eventLock.Unlock()
+	// tick counts restart when the sim does, only count forward gaps as missed
+	if lastTick > 0 && ir.varBufTickCount > lastTick+1 {
+		update.MissedTicks = ir.varBufTickCount - lastTick - 1
+		ir.logger.Debug("missed ticks", zap.Int("missed", update.MissedTicks), zap.Int("tickCount", ir.varBufTickCount))
+	}
+	ir.updateSessionTick = ir.sessionInfoTickCount
+	ir.varHeadersChanged = false
+	ir.lastData = time.Now()
+	ir.setState(Connected)
+	return update, nil
+}
+
+// varLayoutChanged reports whether the variable headers described by cur differ from prev
+func varLayoutChanged(prev, cur *IRHeader) bool {
+	return prev == nil ||
+		prev.NumVars != cur.NumVars ||
+		prev.VarHeaderOffset != cur.VarHeaderOffset ||
+		prev.BufLen != cur.BufLen
+}
+
+// varHeadersModified reports whether the variable headers in memory differ from the ones last read,
+// the sim may rename or move variables keeping their count and the buffer length when the car changes
+func (ir *Client) varHeadersModified() bool {
+	b, err := ir.slice(ir.header.VarHeaderOffset, varHeaderLenth*ir.header.NumVars)
+	return err != nil || !bytes.Equal(b, ir.varHeaderBytes)
+}
@@ -0,0 +1,126 @@
+package iracing
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWaitForData(t *testing.T) {
+	mem := newTestMemory("---\n", []testVar{{"Speed", 10}}, 0)
+	client := NewClient(&ClientConfig{Source: NewMemorySource(mem)})
+	if err := client.open(); err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer client.close()
+
+	update, err := client.WaitForData(10 * time.Millisecond)
+	if err != nil || update == nil {
+		t.Fatalf("first WaitForData got %v %v", update, err)
+	}
+	if update.TickCount != 100 || !update.SessionInfoChanged || !update.VarHeadersChanged {
+		t.Errorf("unexpected first update %+v", update)
+	}
+
+	// nothing new written by the sim
+	if update, err := client.WaitForData(5 * time.Millisecond); err != nil || update != nil {
+		t.Errorf("expected timeout got %+v %v", update, err)
+	}
+
+	// the sim writes buffer 2 three ticks later and updates the session info
+	le := binary.LittleEndian
+	le.PutUint32(mem[bufInfoOffset+2*bufInfoLength:], 103)
+	le.PutUint32(mem[12:16], 2)
+	update, err = client.WaitForData(10 * time.Millisecond)
+	if err != nil || update == nil {
+		t.Fatalf("WaitForData got %v %v", update, err)
+	}
+	if update.TickCount != 103 || update.MissedTicks != 2 || !update.SessionInfoChanged || update.VarHeadersChanged {
+		t.Errorf("unexpected update %+v", update)
+	}
+}
+
+func TestVarHeadersSwapped(t *testing.T) {
+	mem := newTestMemory("---\n", []testVar{{"Speed", 10}, {"RPM", 6000}}, 0)
+	client := NewClient(&ClientConfig{Source: NewMemorySource(mem)})
+	if err := client.open(); err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer client.close()
+	if update, err := client.WaitForData(10 * time.Millisecond); err != nil || update == nil {
+		t.Fatalf("first WaitForData got %v %v", update, err)
+	}
+
+	// the sim swaps the variables keeping their count and the buffer length
+	varHeaderOffset := int(binary.LittleEndian.Uint32(mem[28:32]))
+	speed := mem[varHeaderOffset+16 : varHeaderOffset+48]
+	rpm := mem[varHeaderOffset+varHeaderLenth+16 : varHeaderOffset+varHeaderLenth+48]
+	tmp := append([]byte(nil), speed...)
+	copy(speed, rpm)
+	copy(rpm, tmp)
+	update, err := client.WaitForData(10 * time.Millisecond)
+	if err != nil || update == nil || !update.VarHeadersChanged {
+		t.Fatalf("WaitForData got %+v %v want the variable headers re-read", update, err)
+	}
+	if v, err := client.Float("Speed"); err != nil || v != 6000 {
+		t.Errorf("Speed got %v %v after the swap", v, err)
+	}
+	if v, err := client.Float("RPM"); err != nil || v != 10 {
+		t.Errorf("RPM got %v %v after the swap", v, err)
+	}
+	if update, err := client.WaitForData(5 * time.Millisecond); err != nil || update != nil {
+		t.Errorf("expected timeout got %+v %v", update, err)
+	}
+}
+
+func TestTornSnapshot(t *testing.T) {
+	mem := newTestMemory("---\n", []testVar{{"Speed", 10}}, 0)
+	client := NewClient(&ClientConfig{Source: NewMemorySource(mem)})
+	if err := client.open(); err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer client.close()
+	if update, err := client.WaitForData(10 * time.Millisecond); err != nil || update == nil {
+		t.Fatalf("first WaitForData got %v %v", update, err)
+	}
+
+	// the sim writes the next tick into the buffer during the first tears copies
+	le := binary.LittleEndian
+	copies, tears := 0, 0
+	defer func(c func(dst, src []byte)) { copyVarBuf = c }(copyVarBuf)
+	copyVarBuf = func(dst, src []byte) {
+		copy(dst, src)
+		copies++
+		if copies <= tears {
+			le.PutUint32(mem[bufInfoOffset:], le.Uint32(mem[bufInfoOffset:])+1)
+		}
+	}
+
+	// a torn copy is retried
+	le.PutUint32(mem[bufInfoOffset:], 101)
+	tears = 1
+	update, err := client.WaitForData(10 * time.Millisecond)
+	if err != nil || update == nil || update.TickCount != 102 || copies != 2 {
+		t.Fatalf("WaitForData got %+v %v after %d copies want tick 102 after 2 copies", update, err, copies)
+	}
+
+	// a buffer torn on every retry is skipped keeping the last snapshot
+	le.PutUint32(mem[bufInfoOffset:], 103)
+	copies, tears = 0, snapshotRetries
+	if _, err := client.WaitForData(10 * time.Millisecond); !errors.Is(err, errSnapshotTorn) {
+		t.Fatalf("WaitForData got %v want %v", err, errSnapshotTorn)
+	}
+	if copies != snapshotRetries || client.TickCount() != 102 {
+		t.Errorf("got %d copies tick count %d want %d copies tick count 102", copies, client.TickCount(), snapshotRetries)
+	}
+
+	// nextData skips torn buffers until a copy is not torn
+	copies, tears = 0, 2*snapshotRetries
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if update, err := client.nextData(ctx); err != nil || update == nil || copies != 2*snapshotRetries+1 {
+		t.Errorf("nextData got %+v %v after %d copies want an update after %d", update, err, copies, 2*snapshotRetries+1)
+	}
+}
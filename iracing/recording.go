@@ -0,0 +1,186 @@
+package iracing
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// ErrNotRecording is returned when stopping a recording that is not running
+var ErrNotRecording = errors.New("not recording")
+
+// Recording describes an ibt recording written by Run from the telemetry it reads
+type Recording struct {
+	Path    string
+	Vars    []string // recorded variables, all variables if empty
+	Started time.Time
+	Samples int      // samples written
+	Markers []Marker // markers added while recording
+	Error   string   `json:",omitempty"` // why the recording stopped writing, empty while it is writing
+}
+
+// Marker is a named point in the telemetry stream added with Mark
+type Marker struct {
+	Name        string    `yaml:"Name"`
+	Time        time.Time `yaml:"Time"`
+	TickCount   int       `yaml:"TickCount"`
+	SessionTime float64   `yaml:"SessionTime"`
+}
+
+// recording is a Recording, its file and the writer of the file, created with the first sample
+type recording struct {
+	Recording
+	f *os.File
+	w *IBTWriter
+}
+
+// StartRecording records the variables named in vars to the ibt file at path from the telemetry read by
+// Run, all variables are recorded if vars is empty. The file is created straight away, the headers are
+// written when the next sample is read. Recordings stop with StopRecording or when Run returns.
+// A recording that fails to write stops writing and keeps its Error until it is stopped.
+func (ir *Client) StartRecording(path string, vars []string) error {
+	ir.recordLock.Lock()
+	defer ir.recordLock.Unlock()
+	for _, r := range ir.recordings {
+		if r.Path == path {
+			return fmt.Errorf("already recording %s", path)
+		}
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("creating recording: %w", err)
+	}
+	ir.recordings = append(ir.recordings, &recording{Recording: Recording{
+		Path:    path,
+		Vars:    append([]string(nil), vars...),
+		Started: time.Now(),
+	}, f: f})
+	ir.logger.Info("recording started", zap.String("path", path))
+	return nil
+}
+
+// StopRecording stops the recording to path and closes its file. The recording of a failed recording
+// has its Error set.
+func (ir *Client) StopRecording(path string) (Recording, error) {
+	ir.recordLock.Lock()
+	defer ir.recordLock.Unlock()
+	for i, r := range ir.recordings {
+		if r.Path != path {
+			continue
+		}
+		ir.recordings = append(ir.recordings[:i], ir.recordings[i+1:]...)
+		ir.logger.Info("recording stopped", zap.String("path", path), zap.Int("samples", r.Samples))
+		return r.copy(), r.close()
+	}
+	return Recording{}, fmt.Errorf("%w %s", ErrNotRecording, path)
+}
+
+// Recordings returns the running recordings and the failed recordings that have not been stopped
+func (ir *Client) Recordings() []Recording {
+	ir.recordLock.Lock()
+	defer ir.recordLock.Unlock()
+	recs := make([]Recording, len(ir.recordings))
+	for i, r := range ir.recordings {
+		recs[i] = r.copy()
+	}
+	return recs
+}
+
+// Mark adds a named marker at the most recently read sample to the running recordings and sends it
+// to SessionEvents and the sinks as a MarkerAdded event. The markers of a recording are written into
+// its file when it stops, see IBTReader.Markers.
+func (ir *Client) Mark(name string) Marker {
+	s, tickCount := ir.sampleTick()
+	m := Marker{Name: name, Time: time.Now(), TickCount: tickCount}
+	if s != nil {
+		m.SessionTime, _ = s.Double("SessionTime")
+	}
+
+	ir.recordLock.Lock()
+	for _, r := range ir.recordings {
+		r.Markers = append(r.Markers, m)
+	}
+	ir.recordLock.Unlock()
+
+	ir.emitSessionEvents([]SessionEvent{{Type: MarkerAdded, Time: m.Time, CarIdx: -1, Marker: &m}})
+	return m
+}
+
+// record writes sample to each running recording, writing the headers of new recordings.
+// Recordings that fail stop writing and keep the error for Status.
+func (ir *Client) record(sample *Sample) {
+	ir.recordLock.Lock()
+	defer ir.recordLock.Unlock()
+	if sample == nil {
+		return
+	}
+	for _, r := range ir.recordings {
+		if r.Error != "" {
+			continue
+		}
+		if err := ir.writeRecording(r, sample); err != nil {
+			ir.logger.Error("recording failed", zap.String("path", r.Path), zap.Error(err))
+			r.Error = err.Error()
+			r.close()
+		}
+	}
+}
+
+func (ir *Client) writeRecording(r *recording, sample *Sample) error {
+	if r.w == nil {
+		w, err := NewIBTWriter(r.f, sample, &IBTWriterConfig{
+			TickRate:        ir.header.TickRate,
+			SessionInfoYaml: ir.SessionInfoYaml,
+			Vars:            r.Vars,
+		})
+		if err != nil {
+			return err
+		}
+		w.closer = r.f
+		r.w = w
+	}
+	if err := r.w.WriteSample(sample); err != nil {
+		return err
+	}
+	r.Samples++
+	return nil
+}
+
+// closeRecordings stops every recording when Run returns
+func (ir *Client) closeRecordings() {
+	ir.recordLock.Lock()
+	defer ir.recordLock.Unlock()
+	for _, r := range ir.recordings {
+		if err := r.close(); err != nil {
+			ir.logger.Error("error closing recording", zap.String("path", r.Path), zap.Error(err))
+		}
+	}
+	ir.recordings = nil
+}
+
+// close closes the writer and file of the recording, writing its markers into the file
+func (r *recording) close() error {
+	var err error
+	switch {
+	case r.w != nil:
+		for _, m := range r.Markers {
+			r.w.AddMarker(m)
+		}
+		err = r.w.Close()
+	case r.f != nil:
+		err = r.f.Close()
+	}
+	r.w, r.f = nil, nil
+	return err
+}
+
+// copy returns the recording description without sharing its slices
+func (r *recording) copy() Recording {
+	c := r.Recording
+	c.Vars = append([]string(nil), r.Vars...)
+	c.Markers = append([]Marker(nil), r.Markers...)
+	return c
+}
@@ -0,0 +1,95 @@
+package iracing
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRecordingErrors(t *testing.T) {
+	client := newTestClient(t, "---\n", []testVar{{"Speed", 42}})
+	dir := t.TempDir()
+
+	if err := client.StartRecording(filepath.Join(dir, "missing", "a.ibt"), nil); err == nil {
+		t.Error("expected error recording to a missing directory")
+	}
+	if recs := client.Recordings(); len(recs) != 0 {
+		t.Errorf("unexpected recordings %+v", recs)
+	}
+
+	path := filepath.Join(dir, "unknown.ibt")
+	if err := client.StartRecording(path, []string{"Nope"}); err != nil {
+		t.Fatal(err)
+	}
+	client.record(client.Sample())
+	client.record(client.Sample())
+	recs := client.Status().Recordings
+	if len(recs) != 1 || recs[0].Error == "" || recs[0].Samples != 0 {
+		t.Fatalf("got recordings %+v want the failed recording", recs)
+	}
+	rec, err := client.StopRecording(path)
+	if err != nil || rec.Error != recs[0].Error {
+		t.Errorf("StopRecording got %+v %v", rec, err)
+	}
+	if recs := client.Recordings(); len(recs) != 0 {
+		t.Errorf("unexpected recordings after stop %+v", recs)
+	}
+}
+
+// readMarkers reads the markers of the ibt recording at path
+func readMarkers(t *testing.T, path string) []Marker {
+	t.Helper()
+	r, err := OpenIBT(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	markers, err := r.Markers()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return markers
+}
+
+func TestRecordingMarkers(t *testing.T) {
+	dir := t.TempDir()
+	stopped := filepath.Join(dir, "stopped.ibt")
+	client := newTestClient(t, "---\n", []testVar{{"Speed", 42}})
+	if err := client.StartRecording(stopped, nil); err != nil {
+		t.Fatal(err)
+	}
+	client.record(client.Sample())
+	client.Mark("apex")
+	if _, err := client.StopRecording(stopped); err != nil {
+		t.Fatal(err)
+	}
+	if markers := readMarkers(t, stopped); len(markers) != 1 || markers[0].Name != "apex" || markers[0].TickCount != 100 {
+		t.Errorf("stopped recording markers %+v", markers)
+	}
+
+	// recordings running when Run returns keep their markers
+	running := filepath.Join(dir, "running.ibt")
+	client = NewClient(&ClientConfig{Source: NewMemorySource(newTestMemory("---\n", []testVar{{"Speed", 42}}, 1))})
+	if err := client.StartRecording(running, nil); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error, 1)
+	go func() { done <- client.Run(ctx) }()
+	deadline := time.Now().Add(5 * time.Second)
+	for recs := client.Recordings(); len(recs) != 1 || recs[0].Samples == 0; recs = client.Recordings() {
+		if time.Now().After(deadline) {
+			t.Fatalf("nothing recorded %+v", recs)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	client.Mark("pit")
+	client.Mark("out")
+	cancel()
+	<-done
+	if markers := readMarkers(t, running); len(markers) != 2 || markers[0].Name != "pit" || markers[1].Name != "out" {
+		t.Errorf("running recording markers %+v", markers)
+	}
+}
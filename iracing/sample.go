@@ -0,0 +1,245 @@
+package iracing
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrUnknownVar is returned when reading a variable that is not in the variable headers
+var ErrUnknownVar = errors.New("unknown iracing variable")
+
+// ErrVarType is returned when reading a variable as a type other than its irsdk type
+var ErrVarType = errors.New("iracing variable type mismatch")
+
+// Sample is a buffer of telemetry variables together with the variable headers
+// needed to read them. It is used for both the live client and ibt file records.
+//
+// Every irsdk variable type has an accessor returning the first value of the variable
+// and an array accessor returning all count values, e.g. the 64 values of CarIdx variables.
+type Sample struct {
+	varHeaders map[string]*varHeader
+	buf        []byte
+}
+
+// Char returns the value of the char variable named varName
+func (s *Sample) Char(varName string) (byte, error) {
+	b, err := s.values(varName, irchar, 1)
+	if err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
+
+// Chars returns all the values of the char variable named varName
+func (s *Sample) Chars(varName string) ([]byte, error) {
+	b, err := s.values(varName, irchar, 0)
+	if err != nil {
+		return nil, err
+	}
+	v := make([]byte, len(b))
+	copy(v, b)
+	return v, nil
+}
+
+// Bool returns the value of the bool variable named varName
+func (s *Sample) Bool(varName string) (bool, error) {
+	b, err := s.values(varName, irbool, 1)
+	if err != nil {
+		return false, err
+	}
+	return b[0] != 0, nil
+}
+
+// Bools returns all the values of the bool variable named varName
+func (s *Sample) Bools(varName string) ([]bool, error) {
+	b, err := s.values(varName, irbool, 0)
+	if err != nil {
+		return nil, err
+	}
+	v := make([]bool, len(b))
+	for i := range v {
+		v[i] = b[i] != 0
+	}
+	return v, nil
+}
+
+// Int returns the value of the int variable named varName
+func (s *Sample) Int(varName string) (int32, error) {
+	b, err := s.values(varName, irint, 1)
+	if err != nil {
+		return 0, err
+	}
+	return int32(binary.LittleEndian.Uint32(b)), nil
+}
+
+// Ints returns all the values of the int variable named varName
+func (s *Sample) Ints(varName string) ([]int32, error) {
+	b, err := s.values(varName, irint, 0)
+	if err != nil {
+		return nil, err
+	}
+	v := make([]int32, len(b)/4)
+	for i := range v {
+		v[i] = int32(binary.LittleEndian.Uint32(b[i*4:]))
+	}
+	return v, nil
+}
+
+// BitField returns the value of the bitField variable named varName
+func (s *Sample) BitField(varName string) (uint32, error) {
+	b, err := s.values(varName, irbitField, 1)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(b), nil
+}
+
+// BitFields returns all the values of the bitField variable named varName
+func (s *Sample) BitFields(varName string) ([]uint32, error) {
+	b, err := s.values(varName, irbitField, 0)
+	if err != nil {
+		return nil, err
+	}
+	v := make([]uint32, len(b)/4)
+	for i := range v {
+		v[i] = binary.LittleEndian.Uint32(b[i*4:])
+	}
+	return v, nil
+}
+
+// Float returns the value of the float variable named varName
+func (s *Sample) Float(varName string) (float32, error) {
+	b, err := s.values(varName, irfloat, 1)
+	if err != nil {
+		return 0, err
+	}
+	return math.Float32frombits(binary.LittleEndian.Uint32(b)), nil
+}
+
+// Floats returns all the values of the float variable named varName
+func (s *Sample) Floats(varName string) ([]float32, error) {
+	b, err := s.values(varName, irfloat, 0)
+	if err != nil {
+		return nil, err
+	}
+	v := make([]float32, len(b)/4)
+	for i := range v {
+		v[i] = math.Float32frombits(binary.LittleEndian.Uint32(b[i*4:]))
+	}
+	return v, nil
+}
+
+// Double returns the value of the double variable named varName
+func (s *Sample) Double(varName string) (float64, error) {
+	b, err := s.values(varName, irdouble, 1)
+	if err != nil {
+		return 0, err
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(b)), nil
+}
+
+// Doubles returns all the values of the double variable named varName
+func (s *Sample) Doubles(varName string) ([]float64, error) {
+	b, err := s.values(varName, irdouble, 0)
+	if err != nil {
+		return nil, err
+	}
+	v := make([]float64, len(b)/8)
+	for i := range v {
+		v[i] = math.Float64frombits(binary.LittleEndian.Uint64(b[i*8:]))
+	}
+	return v, nil
+}
+
+// values returns the bytes of the first count values of the variable named varName after checking
+// it has type t. A count of 0 returns all the values of the variable.
+func (s *Sample) values(varName string, t varType, count int) ([]byte, error) {
+	vH := s.varHeaders[varName]
+	if vH == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownVar, varName)
+	}
+	if vH.t != t {
+		return nil, fmt.Errorf("%w: %s is %s not %s", ErrVarType, varName, vH.t, t)
+	}
+	if vH.count < 1 {
+		return nil, fmt.Errorf("variable %s has no values", varName)
+	}
+	if count == 0 || count > vH.count {
+		count = vH.count
+	}
+	end := vH.offset + t.size()*count
+	if end > len(s.buf) {
+		return nil, fmt.Errorf("variable %s at %d:%d outside of buffer length %d", varName, vH.offset, end, len(s.buf))
+	}
+	return s.buf[vH.offset:end], nil
+}
+
+// raw returns the bytes of the variable named varName or nil if the variable is not in the sample
+func (s *Sample) raw(varName string) []byte {
+	vH := s.varHeaders[varName]
+	if vH == nil || vH.offset+vH.length() > len(s.buf) {
+		return nil
+	}
+	return s.buf[vH.offset : vH.offset+vH.length()]
+}
+
+// Value returns the value of the variable named varName as its Go type, byte, bool, int32,
+// uint32, float32 or float64 for the irsdk char, bool, int, bitField, float and double types.
+// Variables with more than one value return a slice of the type. Variables holding irsdk enums
+// and bitfields such as SessionFlags or CarIdxTrackSurface return their enum type e.g. SessionFlags
+// or []TrkLoc, other bitField variables return BitField.
+func (s *Sample) Value(varName string) (interface{}, error) {
+	v, err := s.value(varName)
+	if err != nil {
+		return nil, err
+	}
+	vt := s.varHeaders[varName].t
+	if t, ok := enumVars[varName]; ok && (vt == irint || vt == irbitField) {
+		return enumValue(v, t), nil
+	}
+	if vt == irbitField {
+		return enumValue(v, bitFieldType), nil
+	}
+	return v, nil
+}
+
+// value returns the value of the variable named varName as the Go type of its irsdk type
+func (s *Sample) value(varName string) (interface{}, error) {
+	vH := s.varHeaders[varName]
+	if vH == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownVar, varName)
+	}
+	if vH.count > 1 {
+		switch vH.t {
+		case irchar:
+			return s.Chars(varName)
+		case irbool:
+			return s.Bools(varName)
+		case irint:
+			return s.Ints(varName)
+		case irbitField:
+			return s.BitFields(varName)
+		case irfloat:
+			return s.Floats(varName)
+		case irdouble:
+			return s.Doubles(varName)
+		}
+	}
+	switch vH.t {
+	case irchar:
+		return s.Char(varName)
+	case irbool:
+		return s.Bool(varName)
+	case irint:
+		return s.Int(varName)
+	case irbitField:
+		return s.BitField(varName)
+	case irfloat:
+		return s.Float(varName)
+	case irdouble:
+		return s.Double(varName)
+	}
+	return nil, fmt.Errorf("%w: %s has unknown type %d", ErrVarType, varName, vH.t)
+}
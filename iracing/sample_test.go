@@ -0,0 +1,143 @@
+package iracing
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+)
+
+// newMixedSample returns a sample with char, bool and bitField variables, single and arrays,
+// and variables with no values or outside of the buffer
+func newMixedSample() *Sample {
+	s := &Sample{
+		varHeaders: map[string]*varHeader{
+			"Gear":       {t: irchar, offset: 0, count: 1, name: "Gear"},
+			"Gears":      {t: irchar, offset: 0, count: 3, name: "Gears"},
+			"OnPitRoad":  {t: irbool, offset: 3, count: 1, name: "OnPitRoad"},
+			"CarOnPit":   {t: irbool, offset: 3, count: 3, name: "CarOnPit"},
+			"Flags":      {t: irbitField, offset: 8, count: 1, name: "Flags"},
+			"CarFlags":   {t: irbitField, offset: 8, count: 2, name: "CarFlags"},
+			"Empty":      {t: irint, offset: 0, count: 0, name: "Empty"},
+			"PastBuffer": {t: irint, offset: 12, count: 2, name: "PastBuffer"},
+		},
+		buf: make([]byte, 16),
+	}
+	copy(s.buf, []byte{4, 5, 6, 1, 0, 1})
+	binary.LittleEndian.PutUint32(s.buf[8:], uint32(FlagGreen))
+	binary.LittleEndian.PutUint32(s.buf[12:], uint32(FlagYellow))
+	return s
+}
+
+func TestSampleTypedReads(t *testing.T) {
+	s := newTestSample(12.5, 3, 40)
+
+	if v, err := s.Double("SessionTime"); err != nil || v != 12.5 {
+		t.Errorf("Double got %v %v", v, err)
+	}
+	if v, err := s.Int("Lap"); err != nil || v != 3 {
+		t.Errorf("Int got %v %v", v, err)
+	}
+	if v, err := s.Float("Speed"); err != nil || v != 40 {
+		t.Errorf("Float got %v %v", v, err)
+	}
+	// single value accessors read the first value of an array
+	if v, err := s.Float("ShockDefl"); err != nil || v != 0 {
+		t.Errorf("Float of array got %v %v", v, err)
+	}
+	v, err := s.Floats("ShockDefl")
+	if err != nil || len(v) != 3 || v[0] != 0 || v[1] != 40 || v[2] != 80 {
+		t.Errorf("Floats got %v %v", v, err)
+	}
+}
+
+func TestSampleReadErrors(t *testing.T) {
+	s := newTestSample(12.5, 3, 40)
+
+	if _, err := s.Float("Nope"); !errors.Is(err, ErrUnknownVar) {
+		t.Errorf("expected ErrUnknownVar got %v", err)
+	}
+	if _, err := s.Int("Speed"); !errors.Is(err, ErrVarType) {
+		t.Errorf("expected ErrVarType got %v", err)
+	}
+	if _, err := s.Doubles("Lap"); !errors.Is(err, ErrVarType) {
+		t.Errorf("expected ErrVarType got %v", err)
+	}
+}
+
+func TestSampleCharBoolBitField(t *testing.T) {
+	s := newMixedSample()
+
+	if v, err := s.Char("Gear"); err != nil || v != 4 {
+		t.Errorf("Char got %v %v", v, err)
+	}
+	if v, err := s.Chars("Gears"); err != nil || string(v) != "\x04\x05\x06" {
+		t.Errorf("Chars got %v %v", v, err)
+	}
+	if v, err := s.Bool("OnPitRoad"); err != nil || !v {
+		t.Errorf("Bool got %v %v", v, err)
+	}
+	if v, err := s.Bools("CarOnPit"); err != nil || len(v) != 3 || !v[0] || v[1] || !v[2] {
+		t.Errorf("Bools got %v %v", v, err)
+	}
+	if v, err := s.BitField("Flags"); err != nil || v != uint32(FlagGreen) {
+		t.Errorf("BitField got %x %v", v, err)
+	}
+	if v, err := s.BitFields("CarFlags"); err != nil || len(v) != 2 || v[0] != uint32(FlagGreen) || v[1] != uint32(FlagYellow) {
+		t.Errorf("BitFields got %x %v", v, err)
+	}
+}
+
+func TestSampleCounts(t *testing.T) {
+	s := newMixedSample()
+
+	// single value accessors read the first value of an array
+	if v, err := s.Char("Gears"); err != nil || v != 4 {
+		t.Errorf("Char of array got %v %v", v, err)
+	}
+	if v, err := s.Bool("CarOnPit"); err != nil || !v {
+		t.Errorf("Bool of array got %v %v", v, err)
+	}
+	if v, err := s.BitField("CarFlags"); err != nil || v != uint32(FlagGreen) {
+		t.Errorf("BitField of array got %x %v", v, err)
+	}
+	// array accessors of a single value variable return the one value
+	if v, err := s.Bools("OnPitRoad"); err != nil || len(v) != 1 || !v[0] {
+		t.Errorf("Bools of single value got %v %v", v, err)
+	}
+	if v, err := s.BitFields("Flags"); err != nil || len(v) != 1 || v[0] != uint32(FlagGreen) {
+		t.Errorf("BitFields of single value got %x %v", v, err)
+	}
+
+	if _, err := s.Int("Empty"); err == nil {
+		t.Error("expected an error reading a variable with no values")
+	}
+	if _, err := s.Ints("Empty"); err == nil {
+		t.Error("expected an error reading all values of a variable with no values")
+	}
+	// the first value is in the buffer but not the second
+	if v, err := s.Int("PastBuffer"); err != nil || v != int32(FlagYellow) {
+		t.Errorf("Int of first value got %v %v", v, err)
+	}
+	if _, err := s.Ints("PastBuffer"); err == nil {
+		t.Error("expected an error reading values outside of the buffer")
+	}
+}
+
+func TestSampleTypeMismatch(t *testing.T) {
+	s := newMixedSample()
+
+	reads := map[string]func() error{
+		"Char of bool":      func() error { _, err := s.Char("OnPitRoad"); return err },
+		"Chars of bitField": func() error { _, err := s.Chars("CarFlags"); return err },
+		"Bool of char":      func() error { _, err := s.Bool("Gear"); return err },
+		"Bools of char":     func() error { _, err := s.Bools("Gears"); return err },
+		"BitField of int":   func() error { _, err := s.BitField("PastBuffer"); return err },
+		"BitFields of bool": func() error { _, err := s.BitFields("CarOnPit"); return err },
+		"Int of bitField":   func() error { _, err := s.Int("Flags"); return err },
+	}
+	for name, read := range reads {
+		if err := read(); !errors.Is(err, ErrVarType) {
+			t.Errorf("%s expected ErrVarType got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package iracing
+
+import (
+	"net/http"
+)
+
+// ServerConfig configures the http endpoints of Server
+type ServerConfig struct {
+	Origins []string // origins allowed to open websockets, any origin if empty
+}
+
+// Server serves the telemetry read by a client over http. Every endpoint is fed from the single
+// reader started by Run so Run must be running for telemetry to be served.
+//
+//	/ws                  websocket streaming frames, session info and session events
+//	GET /session         session info yaml, or json with ?format=json
+//	GET /variables       the variable catalog
+//	GET /vars/{name}     latest value of a variable
+//	GET /vars?names=a,b  latest values of variables
+//	GET /status          connection state, tick rate, last tick, sinks and recordings
+//	GET /events          server-sent events of frames, session info, session events, flags and laps
+type Server struct {
+	client *Client
+	cfg    ServerConfig
+	mux    *http.ServeMux
+}
+
+// NewServer creates the http endpoints of client
+func NewServer(client *Client, cfg *ServerConfig) *Server {
+	s := &Server{client: client, mux: http.NewServeMux()}
+	if cfg != nil {
+		s.cfg = *cfg
+	}
+	s.mux.HandleFunc("/ws", s.handleWebSocket)
+	s.mux.HandleFunc("/session", s.handleSession)
+	s.mux.HandleFunc("/variables", s.handleVariables)
+	s.mux.HandleFunc("/vars", s.handleVars)
+	s.mux.HandleFunc("/vars/", s.handleVars)
+	s.mux.HandleFunc("/status", s.handleStatus)
+	s.mux.HandleFunc("/events", s.handleEvents)
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
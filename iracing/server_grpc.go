@@ -0,0 +1,316 @@
+package iracing
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/margic/goiracing/iracingpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// grpcBuffer is the number of frames buffered for each gRPC subscription
+const grpcBuffer = 64
+
+// eventTypes are the protobuf event types of the session event types
+var eventTypes = map[SessionEventType]iracingpb.EventType{
+	DriverJoined:      iracingpb.EventType_EVENT_TYPE_DRIVER_JOINED,
+	DriverLeft:        iracingpb.EventType_EVENT_TYPE_DRIVER_LEFT,
+	DriverSwap:        iracingpb.EventType_EVENT_TYPE_DRIVER_SWAP,
+	ResultsUpdated:    iracingpb.EventType_EVENT_TYPE_RESULTS_UPDATED,
+	SessionNumChanged: iracingpb.EventType_EVENT_TYPE_SESSION_NUM_CHANGED,
+	WeatherChanged:    iracingpb.EventType_EVENT_TYPE_WEATHER_CHANGED,
+	MarkerAdded:       iracingpb.EventType_EVENT_TYPE_MARKER_ADDED,
+}
+
+// telemetryService implements the gRPC Telemetry service with a client
+type telemetryService struct {
+	iracingpb.UnimplementedTelemetryServer
+	client *Client
+}
+
+// NewTelemetryService returns the gRPC Telemetry service of client, register it on a grpc server with
+// iracingpb.RegisterTelemetryServer. Like every subscription the streams are fed from the single reader
+// started by Run so frames and events are only streamed while Run is running.
+func NewTelemetryService(client *Client) iracingpb.TelemetryServer {
+	return &telemetryService{client: client}
+}
+
+func (t *telemetryService) GetSession(ctx context.Context, req *iracingpb.GetSessionRequest) (*iracingpb.Session, error) {
+	info := t.client.SessionYaml()
+	if info == "" {
+		return nil, status.Error(codes.Unavailable, errNoData.Error())
+	}
+	return sessionProto(info, t.client.SessionInfo()), nil
+}
+
+func (t *telemetryService) ListVariables(ctx context.Context, req *iracingpb.ListVariablesRequest) (*iracingpb.ListVariablesResponse, error) {
+	vars := t.client.Vars()
+	if vars == nil {
+		return nil, status.Error(codes.Unavailable, errNoData.Error())
+	}
+	resp := &iracingpb.ListVariablesResponse{Variables: make([]*iracingpb.Variable, len(vars))}
+	for i, v := range vars {
+		resp.Variables[i] = &iracingpb.Variable{
+			Name:        v.Name,
+			Desc:        v.Desc,
+			Unit:        string(v.Unit),
+			Type:        v.Type,
+			Count:       int32(v.Count),
+			CountAsTime: v.CountAsTime,
+		}
+	}
+	return resp, nil
+}
+
+// Subscribe streams frames until the call is cancelled. The stream fails with Unavailable when Run stops.
+func (t *telemetryService) Subscribe(req *iracingpb.SubscribeRequest, stream iracingpb.Telemetry_SubscribeServer) error {
+	units, err := ParseDisplayUnits(req.Units)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Rate < 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid rate %v", req.Rate)
+	}
+	sub := t.client.Subscribe(req.Variables, &SubscribeOptions{Rate: req.Rate, Buffer: grpcBuffer, Units: units})
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case f, ok := <-sub.C:
+			if !ok {
+				return status.Error(codes.Unavailable, "telemetry stopped")
+			}
+			if err := stream.Send(frameProto(f)); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// StreamEvents streams session events until the call is cancelled. The stream fails with Unavailable
+// when Run stops.
+func (t *telemetryService) StreamEvents(req *iracingpb.StreamEventsRequest, stream iracingpb.Telemetry_StreamEventsServer) error {
+	sub := t.client.Subscribe(nil, &SubscribeOptions{SessionOnly: true})
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case m, ok := <-sub.Session:
+			if !ok {
+				return status.Error(codes.Unavailable, "telemetry stopped")
+			}
+			var e *iracingpb.Event
+			switch {
+			case m.Event != nil:
+				e = eventProto(m.Event)
+			case req.SessionInfo:
+				session, _ := ParseSessionInfo(m.SessionInfo)
+				e = &iracingpb.Event{
+					Type:    iracingpb.EventType_EVENT_TYPE_SESSION_INFO,
+					Time:    timestamppb.Now(),
+					CarIdx:  -1,
+					Session: sessionProto(m.SessionInfo, session),
+				}
+			default:
+				continue
+			}
+			if err := stream.Send(e); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// sessionProto returns the session info yaml with the parts of session parsed, session may be nil
+func sessionProto(info string, session *Session) *iracingpb.Session {
+	s := &iracingpb.Session{Yaml: info}
+	if session == nil {
+		return s
+	}
+	w := &session.WeekendInfo
+	s.Weekend = &iracingpb.Weekend{
+		TrackName:        w.TrackName,
+		TrackId:          int32(w.TrackID),
+		TrackDisplayName: w.TrackDisplayName,
+		TrackConfigName:  w.TrackConfigName,
+		TrackLength:      w.TrackLength,
+		EventType:        w.EventType,
+		Category:         w.Category,
+		SeriesId:         int32(w.SeriesID),
+		SessionId:        int32(w.SessionID),
+		SubSessionId:     int32(w.SubSessionID),
+	}
+	for _, d := range session.SessionInfo.Sessions {
+		s.Sessions = append(s.Sessions, &iracingpb.SessionDetail{
+			SessionNum:       int32(d.SessionNum),
+			SessionType:      d.SessionType,
+			SessionName:      d.SessionName,
+			SessionLaps:      d.SessionLaps,
+			SessionTime:      d.SessionTime,
+			ResultsPositions: positionsProto(d.ResultsPositions),
+		})
+	}
+	s.PlayerCarIdx = int32(session.DriverInfo.DriverCarIdx)
+	for i := range session.DriverInfo.Drivers {
+		s.Drivers = append(s.Drivers, driverProto(&session.DriverInfo.Drivers[i]))
+	}
+	return s
+}
+
+func driverProto(d *Driver) *iracingpb.Driver {
+	if d == nil {
+		return nil
+	}
+	return &iracingpb.Driver{
+		CarIdx:            int32(d.CarIdx),
+		UserName:          d.UserName,
+		UserId:            int32(d.UserID),
+		TeamId:            int32(d.TeamID),
+		TeamName:          d.TeamName,
+		CarNumber:         d.CarNumber,
+		CarPath:           d.CarPath,
+		CarScreenName:     d.CarScreenName,
+		CarClassShortName: d.CarClassShortName,
+		Irating:           int32(d.IRating),
+		License:           d.LicString,
+		IsSpectator:       d.IsSpectator != 0,
+		IsPaceCar:         d.CarIsPaceCar != 0,
+	}
+}
+
+func positionsProto(positions []ResultsPosition) []*iracingpb.ResultsPosition {
+	var ps []*iracingpb.ResultsPosition
+	for _, p := range positions {
+		ps = append(ps, &iracingpb.ResultsPosition{
+			Position:      int32(p.Position),
+			ClassPosition: int32(p.ClassPosition),
+			CarIdx:        int32(p.CarIdx),
+			Lap:           int32(p.Lap),
+			Time:          p.Time,
+			FastestLap:    int32(p.FastestLap),
+			FastestTime:   p.FastestTime,
+			LastTime:      p.LastTime,
+			LapsLed:       int32(p.LapsLed),
+			LapsComplete:  int32(p.LapsComplete),
+			Incidents:     int32(p.Incidents),
+			ReasonOut:     p.ReasonOutStr,
+		})
+	}
+	return ps
+}
+
+func eventProto(e *SessionEvent) *iracingpb.Event {
+	pe := &iracingpb.Event{
+		Type:               eventTypes[e.Type],
+		Time:               timestamppb.New(e.Time),
+		CarIdx:             int32(e.CarIdx),
+		Driver:             driverProto(e.Driver),
+		PreviousDriver:     driverProto(e.PreviousDriver),
+		SessionNum:         int32(e.SessionNum),
+		PreviousSessionNum: int32(e.PreviousSessionNum),
+		Positions:          positionsProto(e.Positions),
+	}
+	for _, c := range e.Changes {
+		pe.Changes = append(pe.Changes, &iracingpb.FieldChange{Field: c.Field, From: c.From, To: c.To})
+	}
+	if m := e.Marker; m != nil {
+		pe.Marker = &iracingpb.Marker{
+			Name:        m.Name,
+			Time:        timestamppb.New(m.Time),
+			TickCount:   int64(m.TickCount),
+			SessionTime: m.SessionTime,
+		}
+	}
+	return pe
+}
+
+func frameProto(f *Frame) *iracingpb.Frame {
+	pf := &iracingpb.Frame{
+		TickCount:   int64(f.TickCount),
+		SessionTime: f.SessionTime,
+		Values:      make(map[string]*iracingpb.Value, len(f.Values)),
+	}
+	for name, v := range f.Values {
+		pv, err := valueProto(v)
+		if err != nil {
+			continue
+		}
+		pv.Unit = string(f.Units[name])
+		pf.Values[name] = pv
+	}
+	return pf
+}
+
+// valueProto returns a variable value as a protobuf value by its kind so enum and bit field types are
+// sent as ints with their names in text
+func valueProto(v interface{}) (*iracingpb.Value, error) {
+	rv := reflect.ValueOf(v)
+	pv := &iracingpb.Value{}
+	if s, ok := v.(fmt.Stringer); ok && rv.Kind() != reflect.Slice {
+		pv.Text = s.String()
+	}
+	switch rv.Kind() {
+	case reflect.Bool:
+		pv.Kind = &iracingpb.Value_BoolValue{BoolValue: rv.Bool()}
+	case reflect.Int32:
+		pv.Kind = &iracingpb.Value_IntValue{IntValue: int32(rv.Int())}
+	case reflect.Uint32:
+		pv.Kind = &iracingpb.Value_BitFieldValue{BitFieldValue: uint32(rv.Uint())}
+	case reflect.Float32:
+		pv.Kind = &iracingpb.Value_FloatValue{FloatValue: float32(rv.Float())}
+	case reflect.Float64:
+		pv.Kind = &iracingpb.Value_DoubleValue{DoubleValue: rv.Float()}
+	case reflect.Uint8:
+		pv.Kind = &iracingpb.Value_CharValue{CharValue: []byte{byte(rv.Uint())}}
+	case reflect.Slice:
+		n := rv.Len()
+		switch rv.Type().Elem().Kind() {
+		case reflect.Bool:
+			a := make([]bool, n)
+			for i := range a {
+				a[i] = rv.Index(i).Bool()
+			}
+			pv.Kind = &iracingpb.Value_BoolArray{BoolArray: &iracingpb.BoolArray{Values: a}}
+		case reflect.Int32:
+			a := make([]int32, n)
+			for i := range a {
+				a[i] = int32(rv.Index(i).Int())
+			}
+			pv.Kind = &iracingpb.Value_IntArray{IntArray: &iracingpb.IntArray{Values: a}}
+		case reflect.Uint32:
+			a := make([]uint32, n)
+			for i := range a {
+				a[i] = uint32(rv.Index(i).Uint())
+			}
+			pv.Kind = &iracingpb.Value_BitFieldArray{BitFieldArray: &iracingpb.BitFieldArray{Values: a}}
+		case reflect.Float32:
+			a := make([]float32, n)
+			for i := range a {
+				a[i] = float32(rv.Index(i).Float())
+			}
+			pv.Kind = &iracingpb.Value_FloatArray{FloatArray: &iracingpb.FloatArray{Values: a}}
+		case reflect.Float64:
+			a := make([]float64, n)
+			for i := range a {
+				a[i] = rv.Index(i).Float()
+			}
+			pv.Kind = &iracingpb.Value_DoubleArray{DoubleArray: &iracingpb.DoubleArray{Values: a}}
+		case reflect.Uint8:
+			a := make([]byte, n)
+			for i := range a {
+				a[i] = byte(rv.Index(i).Uint())
+			}
+			pv.Kind = &iracingpb.Value_CharValue{CharValue: a}
+		default:
+			return nil, fmt.Errorf("%w: unsupported array value %T", ErrVarType, v)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unsupported value %T", ErrVarType, v)
+	}
+	return pv, nil
+}
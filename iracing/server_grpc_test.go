@@ -0,0 +1,123 @@
+package iracing
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/margic/goiracing/iracingpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// dialTelemetryService serves the telemetry service of client over an in memory connection
+func dialTelemetryService(t *testing.T, client *Client) iracingpb.TelemetryClient {
+	t.Helper()
+	ln := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	iracingpb.RegisterTelemetryServer(srv, NewTelemetryService(client))
+	go srv.Serve(ln)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return ln.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return iracingpb.NewTelemetryClient(conn)
+}
+
+func TestTelemetryService(t *testing.T) {
+	session := "---\nWeekendInfo:\n TrackName: spa\nDriverInfo:\n DriverCarIdx: 1\n Drivers:\n - CarIdx: 1\n   UserName: Jo\n"
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	empty := dialTelemetryService(t, NewClient(&ClientConfig{Source: NewMemorySource(nil)}))
+	if _, err := empty.GetSession(ctx, &iracingpb.GetSessionRequest{}); status.Code(err) != codes.Unavailable {
+		t.Errorf("got %v want unavailable before reading", err)
+	}
+
+	client := newTestClient(t, session, []testVar{{"Speed", 42}})
+	tc := dialTelemetryService(t, client)
+
+	s, err := tc.GetSession(ctx, &iracingpb.GetSessionRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Yaml != session || s.Weekend.TrackName != "spa" || s.PlayerCarIdx != 1 || len(s.Drivers) != 1 || s.Drivers[0].UserName != "Jo" {
+		t.Errorf("unexpected session %v", s)
+	}
+	vars, err := tc.ListVariables(ctx, &iracingpb.ListVariablesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vars.Variables) != 1 || vars.Variables[0].Name != "Speed" || vars.Variables[0].Type != "float" {
+		t.Errorf("unexpected variables %v", vars.Variables)
+	}
+
+	frames, err := tc.Subscribe(ctx, &iracingpb.SubscribeRequest{Variables: []string{"Lap", "Speed"}, Rate: 30})
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := tc.StreamEvents(ctx, &iracingpb.StreamEventsRequest{SessionInfo: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitForSubscriptions(t, client, 2)
+	for tick := 1; tick <= 3; tick++ {
+		client.publish(ctx, tick, 60, newTestSample(float64(tick)/60, tick, float32(tick)))
+	}
+	for _, want := range []int64{1, 3} {
+		f, err := frames.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f.TickCount != want || f.Values["Speed"].GetFloatValue() != float32(want) || f.Values["Speed"].Unit != "m/s" || f.Values["Lap"].GetIntValue() != int32(want) {
+			t.Errorf("got frame %v want tick %d", f, want)
+		}
+	}
+
+	client.publishSession(SessionMessage{SessionInfo: session})
+	client.Mark("apex")
+	for _, want := range []iracingpb.EventType{iracingpb.EventType_EVENT_TYPE_SESSION_INFO, iracingpb.EventType_EVENT_TYPE_MARKER_ADDED} {
+		if want == iracingpb.EventType_EVENT_TYPE_MARKER_ADDED {
+			// markers reach subscriptions with the next update of the reader
+			e := <-client.SessionEvents()
+			client.publishSession(SessionMessage{Event: &e})
+		}
+		e, err := events.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e.Type != want {
+			t.Errorf("got event %v want %v", e.Type, want)
+		}
+		if want == iracingpb.EventType_EVENT_TYPE_MARKER_ADDED && e.Marker.GetName() != "apex" {
+			t.Errorf("unexpected marker %v", e.Marker)
+		}
+	}
+
+	client.unsubscribeAll()
+	if _, err := frames.Recv(); status.Code(err) != codes.Unavailable {
+		t.Errorf("got %v want unavailable when the reader stops", err)
+	}
+}
+
+func TestValueProto(t *testing.T) {
+	v, err := valueProto(FlagGreen | FlagBlue)
+	if err != nil || v.GetBitFieldValue() != uint32(FlagGreen|FlagBlue) || v.Text != "green|blue" {
+		t.Errorf("got %v %v", v, err)
+	}
+	v, err = valueProto([]TrkLoc{TrkLocOnTrack, TrkLocNotInWorld})
+	if err != nil || len(v.GetIntArray().GetValues()) != 2 || v.GetIntArray().Values[1] != -1 {
+		t.Errorf("got %v %v", v, err)
+	}
+	if _, err := valueProto("nope"); err == nil {
+		t.Error("expected error for unsupported value")
+	}
+}
@@ -0,0 +1,150 @@
+package iracing
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// errNoData is replied with 503 Service Unavailable when nothing has been read from the sim yet
+var errNoData = errors.New("no telemetry has been read from the sim")
+
+// restError is the json body of an error reply
+type restError struct {
+	Error string
+}
+
+// handleSession replies with the session info yaml, or as json with ?format=json or an Accept header
+// of application/json. ?path= replies with the json value at a session info path e.g.
+// DriverInfo:Drivers:CarIdx:{3}UserName:
+func (s *Server) handleSession(w http.ResponseWriter, r *http.Request) {
+	if !allowGet(w, r) {
+		return
+	}
+	if path := r.URL.Query().Get("path"); path != "" {
+		if s.client.SessionYaml() == "" {
+			writeError(w, http.StatusServiceUnavailable, errNoData)
+			return
+		}
+		v, err := s.client.QuerySession(path)
+		switch {
+		case errors.Is(err, ErrSessionPathNotFound):
+			writeError(w, http.StatusNotFound, err)
+		case err != nil:
+			writeError(w, http.StatusBadRequest, err)
+		default:
+			writeJSON(w, http.StatusOK, v)
+		}
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" && strings.Contains(r.Header.Get("Accept"), "application/json") {
+		format = "json"
+	}
+	switch format {
+	case "", "yaml":
+		info := s.client.SessionYaml()
+		if info == "" {
+			writeError(w, http.StatusServiceUnavailable, errNoData)
+			return
+		}
+		w.Header().Set("Content-Type", "application/yaml; charset=utf-8")
+		fmt.Fprint(w, info)
+	case "json":
+		session := s.client.SessionInfo()
+		if session == nil {
+			writeError(w, http.StatusServiceUnavailable, errNoData)
+			return
+		}
+		writeJSON(w, http.StatusOK, session)
+	default:
+		writeError(w, http.StatusBadRequest, fmt.Errorf("unknown session info format %s", format))
+	}
+}
+
+// handleVariables replies with the name, type, unit, count and description of every variable
+func (s *Server) handleVariables(w http.ResponseWriter, r *http.Request) {
+	if !allowGet(w, r) {
+		return
+	}
+	vars := s.client.Vars()
+	if vars == nil {
+		writeError(w, http.StatusServiceUnavailable, errNoData)
+		return
+	}
+	writeJSON(w, http.StatusOK, vars)
+}
+
+// handleVars replies with a frame of the latest values of the variable named in the path of
+// /vars/{name}, or of the variables in ?names=a,b of /vars. ?units=metric or imperial converts
+// the values to display units.
+func (s *Server) handleVars(w http.ResponseWriter, r *http.Request) {
+	if !allowGet(w, r) {
+		return
+	}
+	var names []string
+	if name := strings.TrimPrefix(r.URL.Path, "/vars/"); name != r.URL.Path && name != "" {
+		names = []string{name}
+	} else {
+		names = splitQuery(r.URL.Query().Get("names"))
+	}
+	if len(names) == 0 {
+		writeError(w, http.StatusBadRequest, errors.New("no variable names, use /vars/{name} or /vars?names=a,b"))
+		return
+	}
+	units, err := ParseDisplayUnits(r.URL.Query().Get("units"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	sample, tickCount := s.client.sampleTick()
+	if sample == nil {
+		writeError(w, http.StatusServiceUnavailable, errNoData)
+		return
+	}
+	for _, name := range names {
+		if _, ok := sample.varHeaders[name]; !ok {
+			writeError(w, http.StatusNotFound, fmt.Errorf("%w: %s", ErrUnknownVar, name))
+			return
+		}
+	}
+	sub := &Subscription{vars: names, opts: SubscribeOptions{Units: units}}
+	writeJSON(w, http.StatusOK, sub.frame(tickCount, sample))
+}
+
+// handleStatus replies with the connection state, tick rate, last tick, sinks and recordings
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) {
+	if !allowGet(w, r) {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.client.Status())
+}
+
+// allowGet replies 405 Method Not Allowed to requests other than GET and HEAD
+func allowGet(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		return true
+	}
+	w.Header().Set("Allow", "GET, HEAD")
+	writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+	return false
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		code = http.StatusInternalServerError
+		b, _ = json.Marshal(restError{Error: fmt.Sprintf("encoding reply: %v", err)})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(b)
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	writeJSON(w, code, restError{Error: err.Error()})
+}
@@ -0,0 +1,97 @@
+package iracing
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// getJSON gets url checking the status code and decodes the json reply into v
+func getJSON(t *testing.T, url string, code int, v interface{}) {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != code {
+		t.Fatalf("%s got status %d want %d: %s", url, resp.StatusCode, code, b)
+	}
+	if v != nil {
+		if err := json.Unmarshal(b, v); err != nil {
+			t.Fatalf("%s reply %s: %v", url, b, err)
+		}
+	}
+}
+
+func TestServerREST(t *testing.T) {
+	session := "---\nWeekendInfo:\n TrackName: spa\n"
+	empty := httptest.NewServer(NewServer(NewClient(&ClientConfig{Source: NewMemorySource(nil)}), nil))
+	defer empty.Close()
+	getJSON(t, empty.URL+"/variables", http.StatusServiceUnavailable, nil)
+
+	client := newTestClient(t, session, []testVar{{"Speed", 42}, {"RPM", 6000}})
+	srv := httptest.NewServer(NewServer(client, nil))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/session")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(b) != session || resp.Header.Get("Content-Type") != "application/yaml; charset=utf-8" {
+		t.Errorf("session got %q %s", b, resp.Header.Get("Content-Type"))
+	}
+	var info Session
+	getJSON(t, srv.URL+"/session?format=json", http.StatusOK, &info)
+	if info.WeekendInfo.TrackName != "spa" {
+		t.Errorf("session json got %+v", info.WeekendInfo)
+	}
+	var track string
+	getJSON(t, srv.URL+"/session?path=WeekendInfo:TrackName:", http.StatusOK, &track)
+	if track != "spa" {
+		t.Errorf("session path got %q", track)
+	}
+	getJSON(t, srv.URL+"/session?path=WeekendInfo:Nope:", http.StatusNotFound, nil)
+
+	var vars []VarInfo
+	getJSON(t, srv.URL+"/variables", http.StatusOK, &vars)
+	if len(vars) != 2 || vars[1].Name != "RPM" || vars[1].Unit != "m" || vars[1].Type != "float" || vars[1].Count != 1 {
+		t.Errorf("variables got %+v", vars)
+	}
+
+	var frame Frame
+	getJSON(t, srv.URL+"/vars/Speed", http.StatusOK, &frame)
+	if frame.TickCount != 100 || len(frame.Values) != 1 || frame.Values["Speed"] != 42.0 {
+		t.Errorf("vars/Speed got %+v", frame)
+	}
+	frame = Frame{}
+	getJSON(t, srv.URL+"/vars?names=Speed,RPM", http.StatusOK, &frame)
+	if len(frame.Values) != 2 || frame.Values["RPM"] != 6000.0 {
+		t.Errorf("vars?names got %+v", frame)
+	}
+	getJSON(t, srv.URL+"/vars/Nope", http.StatusNotFound, nil)
+	getJSON(t, srv.URL+"/vars", http.StatusBadRequest, nil)
+
+	var status Status
+	getJSON(t, srv.URL+"/status", http.StatusOK, &status)
+	if status.State != Connected || status.TickCount != 100 || status.TickRate != 60 || status.LastTick.IsZero() {
+		t.Errorf("status got %+v", status)
+	}
+
+	resp, err = http.Post(srv.URL+"/status", "application/json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("post status got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,224 @@
+package iracing
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sseKeepAlive is how often a comment is sent to idle event stream clients so proxies keep the stream open
+const sseKeepAlive = 15 * time.Second
+
+// sseBuffer is the number of frames buffered for each event stream client
+const sseBuffer = 64
+
+// sseEventTypes are the named events of the event stream
+var sseEventTypes = []string{"frame", "session", "event", "flag", "lap"}
+
+// sseWatchVars are the variables watched every tick for flag and lap events
+var sseWatchVars = []string{"SessionFlags", "Lap", "LapCompleted", "LapLastLapTime"}
+
+// FlagEvent is the data of a flag event sent when the session flags change
+type FlagEvent struct {
+	TickCount   int
+	SessionTime float64
+	Flags       SessionFlags
+	Previous    SessionFlags
+}
+
+// LapEvent is the data of a lap event sent when the player starts a lap
+type LapEvent struct {
+	TickCount    int
+	SessionTime  float64
+	Lap          int32
+	LapCompleted int32
+	LastLapTime  float32 // time of the lap completed, -1 if it was not timed
+}
+
+// sseStream tracks what an event stream client was last sent
+type sseStream struct {
+	w      io.Writer
+	events map[string]bool
+
+	flagsSeen bool
+	flags     SessionFlags
+	lapSeen   bool
+	lap       int32
+}
+
+// handleEvents streams server-sent events to a client. ?names=a,b selects the variables of frame
+// events, every variable if empty, ?rate= limits frames per second and ?units= converts them to
+// metric or imperial display units. ?events=frame,lap selects the named events sent, all by default:
+//
+//	frame    a frame of the selected variables
+//	session  the session info yaml when the sim updates it, and on connect
+//	event    a change found in the session info, e.g. a driver joining
+//	flag     the session flags when they change, and on connect
+//	lap      the player lap when it changes, and on connect
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	if !allowGet(w, r) {
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, fmt.Errorf("streaming not supported"))
+		return
+	}
+	q := r.URL.Query()
+	stream := &sseStream{w: w, events: map[string]bool{}}
+	for _, e := range splitQuery(q.Get("events")) {
+		if !containsString(sseEventTypes, e) {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("unknown event %s, events %v", e, sseEventTypes))
+			return
+		}
+		stream.events[e] = true
+	}
+	if len(stream.events) == 0 {
+		for _, e := range sseEventTypes {
+			stream.events[e] = true
+		}
+	}
+	var rate float64
+	if v := q.Get("rate"); v != "" {
+		var err error
+		if rate, err = strconv.ParseFloat(v, 64); err != nil || rate < 0 {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid rate %s", v))
+			return
+		}
+	}
+	units, err := ParseDisplayUnits(q.Get("units"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// frames are rate limited, flags and laps are watched every tick so no change is missed
+	var frames, watch *Subscription
+	if stream.events["frame"] {
+		frames = s.client.Subscribe(splitQuery(q.Get("names")), &SubscribeOptions{Rate: rate, Buffer: sseBuffer, Units: units})
+		defer frames.Unsubscribe()
+	}
+	watchFrames := stream.events["flag"] || stream.events["lap"]
+	watchSession := stream.events["session"] || stream.events["event"]
+	switch {
+	case watchFrames:
+		watch = s.client.Subscribe(sseWatchVars, &SubscribeOptions{Buffer: sseBuffer, Session: watchSession})
+		defer watch.Unsubscribe()
+	case watchSession:
+		watch = s.client.Subscribe(nil, &SubscribeOptions{SessionOnly: true})
+		defer watch.Unsubscribe()
+	}
+
+	h := w.Header()
+	h.Set("Content-Type", "text/event-stream")
+	h.Set("Cache-Control", "no-cache")
+	h.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if info := s.client.SessionYaml(); info != "" && stream.events["session"] {
+		stream.send("session", info)
+	}
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(sseKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		var err error
+		select {
+		case f, ok := <-subFrames(frames):
+			if !ok {
+				return
+			}
+			err = stream.sendJSON("frame", f)
+		case f, ok := <-subFrames(watch):
+			if !ok {
+				return
+			}
+			err = stream.watch(f)
+		case m, ok := <-subSession(watch):
+			if !ok {
+				return
+			}
+			switch {
+			case m.Event != nil && stream.events["event"]:
+				err = stream.sendJSON("event", m.Event)
+			case m.Event == nil && stream.events["session"]:
+				err = stream.send("session", m.SessionInfo)
+			}
+		case <-keepAlive.C:
+			_, err = io.WriteString(w, ": keepalive\n\n")
+		case <-r.Context().Done():
+			return
+		}
+		if err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+// watch sends flag and lap events for the changes in a frame of the watched variables
+func (st *sseStream) watch(f *Frame) error {
+	if flags, ok := f.Values["SessionFlags"].(SessionFlags); ok && st.events["flag"] && (!st.flagsSeen || flags != st.flags) {
+		e := FlagEvent{TickCount: f.TickCount, SessionTime: f.SessionTime, Flags: flags, Previous: st.flags}
+		st.flagsSeen, st.flags = true, flags
+		if err := st.sendJSON("flag", e); err != nil {
+			return err
+		}
+	}
+	if lap, ok := f.Values["Lap"].(int32); ok && st.events["lap"] && (!st.lapSeen || lap != st.lap) {
+		e := LapEvent{TickCount: f.TickCount, SessionTime: f.SessionTime, Lap: lap, LastLapTime: -1}
+		e.LapCompleted, _ = f.Values["LapCompleted"].(int32)
+		if t, ok := f.Values["LapLastLapTime"].(float32); ok {
+			e.LastLapTime = t
+		}
+		st.lapSeen, st.lap = true, lap
+		if err := st.sendJSON("lap", e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (st *sseStream) sendJSON(event string, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return st.send(event, string(b))
+}
+
+// send writes a named event, each line of data on its own data field
+func (st *sseStream) send(event, data string) error {
+	var b strings.Builder
+	b.WriteString("event: " + event + "\n")
+	for _, line := range strings.Split(data, "\n") {
+		b.WriteString("data: " + line + "\n")
+	}
+	b.WriteString("\n")
+	_, err := io.WriteString(st.w, b.String())
+	return err
+}
+
+// splitQuery splits a comma separated query value, dropping empty values
+func splitQuery(v string) []string {
+	var values []string
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			values = append(values, s)
+		}
+	}
+	return values
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+package iracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// newFlagSample returns a sample with session flags, lap and speed variables
+func newFlagSample(flags SessionFlags, lap int, speed float32) *Sample {
+	s := &Sample{
+		varHeaders: map[string]*varHeader{
+			"SessionFlags": {t: irbitField, offset: 0, count: 1, name: "SessionFlags"},
+			"Lap":          {t: irint, offset: 4, count: 1, name: "Lap"},
+			"Speed":        {t: irfloat, offset: 8, count: 1, name: "Speed", unit: "m/s"},
+		},
+		buf: make([]byte, 12),
+	}
+	binary.LittleEndian.PutUint32(s.buf[0:], uint32(flags))
+	binary.LittleEndian.PutUint32(s.buf[4:], uint32(lap))
+	binary.LittleEndian.PutUint32(s.buf[8:], math.Float32bits(speed))
+	return s
+}
+
+// readSSE reads the next named event from an event stream
+func readSSE(t *testing.T, r *bufio.Reader) (event, data string) {
+	t.Helper()
+	var lines []string
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "":
+			return event, strings.Join(lines, "\n")
+		case strings.HasPrefix(line, "event: "):
+			event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			lines = append(lines, strings.TrimPrefix(line, "data: "))
+		}
+	}
+}
+
+func TestServerEvents(t *testing.T) {
+	client := NewClient(&ClientConfig{Source: NewMemorySource(nil)})
+	srv := httptest.NewServer(NewServer(client, nil))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/events?events=nope")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("unknown event got status %d", resp.StatusCode)
+	}
+
+	resp, err = http.Get(srv.URL + "/events?names=Speed&rate=30&events=frame,session,flag,lap")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("content type %s", ct)
+	}
+	waitForSubscriptions(t, client, 2)
+
+	// the yellow flag and second lap come on tick 2 which is between the frames of ticks 1 and 3
+	samples := []*Sample{newFlagSample(FlagGreen, 1, 10), newFlagSample(FlagYellow, 2, 20), newFlagSample(FlagYellow, 2, 30)}
+	for i, s := range samples {
+		client.publish(context.Background(), i+1, 60, s)
+	}
+	client.publishSession(SessionMessage{SessionInfo: "---\nWeekendInfo:\n TrackName: spa\n"})
+
+	r := bufio.NewReader(resp.Body)
+	var frames []int
+	var flags []string
+	var laps []int32
+	var session string
+	for i := 0; i < 7; i++ {
+		event, data := readSSE(t, r)
+		switch event {
+		case "frame":
+			var f Frame
+			if err := json.Unmarshal([]byte(data), &f); err != nil {
+				t.Fatal(err)
+			}
+			frames = append(frames, f.TickCount)
+		case "flag":
+			var e struct{ Flags []string }
+			if err := json.Unmarshal([]byte(data), &e); err != nil {
+				t.Fatal(err)
+			}
+			flags = append(flags, strings.Join(e.Flags, "|"))
+		case "lap":
+			var e LapEvent
+			if err := json.Unmarshal([]byte(data), &e); err != nil {
+				t.Fatal(err)
+			}
+			laps = append(laps, e.Lap)
+		case "session":
+			session = data
+		default:
+			t.Errorf("unexpected event %s %s", event, data)
+		}
+	}
+	if len(frames) != 2 || frames[0] != 1 || frames[1] != 3 {
+		t.Errorf("frames of ticks %v", frames)
+	}
+	if len(flags) != 2 || flags[0] != "green" || flags[1] != "yellow" {
+		t.Errorf("flags %v", flags)
+	}
+	if len(laps) != 2 || laps[0] != 1 || laps[1] != 2 {
+		t.Errorf("laps %v", laps)
+	}
+	if session != "---\nWeekendInfo:\n TrackName: spa\n" {
+		t.Errorf("session %q", session)
+	}
+}
+
+func TestServerEventsSessionOnly(t *testing.T) {
+	client := NewClient(&ClientConfig{Source: NewMemorySource(nil)})
+	srv := httptest.NewServer(NewServer(client, nil))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/events?events=session,event")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	waitForSubscriptions(t, client, 1)
+	client.subLock.Lock()
+	sub := client.subscriptions[0]
+	client.subLock.Unlock()
+	if !sub.opts.SessionOnly || len(sub.vars) != 0 {
+		t.Errorf("session events watch frames of %v", sub.vars)
+	}
+
+	client.publish(context.Background(), 1, 60, newFlagSample(FlagGreen, 1, 10))
+	client.publishSession(SessionMessage{Event: &SessionEvent{Type: DriverJoined, CarIdx: 3}})
+	if event, data := readSSE(t, bufio.NewReader(resp.Body)); event != "event" || !strings.Contains(data, "driver joined") {
+		t.Errorf("got %s %s want the driver joined event", event, data)
+	}
+}
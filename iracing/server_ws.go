@@ -0,0 +1,199 @@
+package iracing
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+const (
+	// wsWriteTimeout is how long a write to a websocket client may take
+	wsWriteTimeout = 5 * time.Second
+	// wsPongTimeout is how long a websocket client may go without answering a ping
+	wsPongTimeout = 60 * time.Second
+	// wsPingInterval is how often websocket clients are pinged, shorter than wsPongTimeout
+	wsPingInterval = 30 * time.Second
+	// wsBuffer is the number of frames buffered for each websocket client
+	wsBuffer = 64
+	// wsMaxRequest is the largest message accepted from websocket clients
+	wsMaxRequest = 64 * 1024
+)
+
+// WebSocketRequest is a json message sent by websocket clients. Type subscribe replaces the
+// subscription of the connection with one to Vars, every variable if empty, at most Rate frames
+// per second in Units, metric or imperial. Type unsubscribe ends the subscription.
+type WebSocketRequest struct {
+	Type  string
+	Vars  []string
+	Rate  float64
+	Units string
+}
+
+// wsRequest is a request read from a websocket client or the reason it could not be decoded
+type wsRequest struct {
+	WebSocketRequest
+	err error
+}
+
+// handleWebSocket streams frames, session info and session events to a websocket client as json
+// WriterMessages once it subscribes. The current session info is sent on each subscribe.
+func (s *Server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
+	upgrader := websocket.Upgrader{CheckOrigin: s.checkOrigin}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader has replied with the error
+		return
+	}
+	defer conn.Close()
+	log := s.client.logger.With(zap.String("remote", r.RemoteAddr))
+	log.Debug("websocket client connected")
+
+	requests := make(chan wsRequest)
+	quit := make(chan struct{})
+	defer close(quit)
+	readDone := make(chan struct{})
+	go readWebSocket(conn, requests, quit, readDone)
+
+	var sub *Subscription
+	defer func() {
+		if sub != nil {
+			sub.Unsubscribe()
+		}
+	}()
+	ping := time.NewTicker(wsPingInterval)
+	defer ping.Stop()
+
+	for {
+		var msg *WriterMessage
+		select {
+		case req := <-requests:
+			if sub != nil {
+				sub.Unsubscribe()
+				sub = nil
+			}
+			msg = s.webSocketRequest(req, &sub)
+		case f, ok := <-subFrames(sub):
+			if !ok {
+				closeWebSocket(conn, websocket.CloseGoingAway, "telemetry stopped")
+				return
+			}
+			msg = &WriterMessage{Type: "frame", Frame: f}
+		case m, ok := <-subSession(sub):
+			if !ok {
+				closeWebSocket(conn, websocket.CloseGoingAway, "telemetry stopped")
+				return
+			}
+			msg = sessionWriterMessage(m)
+		case <-ping.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteTimeout)); err != nil {
+				log.Debug("websocket ping failed", zap.Error(err))
+				return
+			}
+		case <-readDone:
+			log.Debug("websocket client disconnected")
+			return
+		}
+		if msg == nil {
+			continue
+		}
+		conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+		if err := conn.WriteJSON(msg); err != nil {
+			log.Debug("websocket write failed", zap.Error(err))
+			return
+		}
+	}
+}
+
+// webSocketRequest carries out a client request, setting sub to a new subscription.
+// Returns the message to reply with, if any.
+func (s *Server) webSocketRequest(req wsRequest, sub **Subscription) *WriterMessage {
+	if req.err != nil {
+		return &WriterMessage{Type: "error", Error: req.err.Error()}
+	}
+	switch req.Type {
+	case "subscribe":
+		units, err := ParseDisplayUnits(req.Units)
+		if err != nil {
+			return &WriterMessage{Type: "error", Error: err.Error()}
+		}
+		*sub = s.client.Subscribe(req.Vars, &SubscribeOptions{Rate: req.Rate, Buffer: wsBuffer, Units: units, Session: true})
+		if info := s.client.SessionYaml(); info != "" {
+			return &WriterMessage{Type: "session", SessionInfo: info}
+		}
+		return nil
+	case "unsubscribe":
+		return nil
+	}
+	return &WriterMessage{Type: "error", Error: fmt.Sprintf("unknown request type %q", req.Type)}
+}
+
+// readWebSocket reads client requests until the connection fails or quit is closed, then closes done
+func readWebSocket(conn *websocket.Conn, requests chan<- wsRequest, quit <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+	conn.SetReadLimit(wsMaxRequest)
+	conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	})
+	for {
+		_, b, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var req wsRequest
+		if err := json.Unmarshal(b, &req.WebSocketRequest); err != nil {
+			req.err = fmt.Errorf("decoding request: %w", err)
+		}
+		select {
+		case requests <- req:
+		case <-quit:
+			return
+		}
+	}
+}
+
+// checkOrigin allows websockets from the configured origins, or from any origin if none are configured
+func (s *Server) checkOrigin(r *http.Request) bool {
+	if len(s.cfg.Origins) == 0 {
+		return true
+	}
+	origin := r.Header.Get("Origin")
+	for _, o := range s.cfg.Origins {
+		if o == "*" || o == origin {
+			return true
+		}
+	}
+	return false
+}
+
+// closeWebSocket sends a close message with code and reason
+func closeWebSocket(conn *websocket.Conn, code int, reason string) {
+	conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(wsWriteTimeout))
+}
+
+// subFrames returns the frames of sub, nil blocks forever when there is no subscription
+func subFrames(sub *Subscription) <-chan *Frame {
+	if sub == nil {
+		return nil
+	}
+	return sub.C
+}
+
+// subSession returns the session messages of sub, nil blocks forever when there is no subscription
+func subSession(sub *Subscription) <-chan SessionMessage {
+	if sub == nil {
+		return nil
+	}
+	return sub.Session
+}
+
+func sessionWriterMessage(m SessionMessage) *WriterMessage {
+	if m.Event != nil {
+		return &WriterMessage{Type: "event", Event: m.Event}
+	}
+	return &WriterMessage{Type: "session", SessionInfo: m.SessionInfo}
+}
@@ -0,0 +1,90 @@
+package iracing
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// waitForSubscriptions waits until client has n subscriptions
+func waitForSubscriptions(t *testing.T, client *Client, n int) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		client.subLock.Lock()
+		got := len(client.subscriptions)
+		client.subLock.Unlock()
+		if got == n {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("got %d subscriptions want %d", got, n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestServerWebSocket(t *testing.T) {
+	client := NewClient(&ClientConfig{Source: NewMemorySource(nil)})
+	srv := httptest.NewServer(NewServer(client, nil))
+	defer srv.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/ws", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	if err := conn.WriteJSON(WebSocketRequest{Type: "bogus"}); err != nil {
+		t.Fatal(err)
+	}
+	var msg WriterMessage
+	if err := conn.ReadJSON(&msg); err != nil || msg.Type != "error" {
+		t.Fatalf("got %+v %v want error", msg, err)
+	}
+
+	if err := conn.WriteJSON(WebSocketRequest{Type: "subscribe", Vars: []string{"Speed"}, Rate: 30}); err != nil {
+		t.Fatal(err)
+	}
+	waitForSubscriptions(t, client, 1)
+	for tick := 1; tick <= 3; tick++ {
+		client.publish(context.Background(), tick, 60, newTestSample(0, tick, float32(tick)))
+	}
+	client.publishSession(SessionMessage{SessionInfo: "---\n"})
+	client.publishSession(SessionMessage{Event: &SessionEvent{Type: DriverJoined, CarIdx: 4}})
+
+	// 30 frames per second at 60Hz delivers ticks 1 and 3, frames and session messages are not ordered
+	var ticks []int
+	var session, event bool
+	for i := 0; i < 4; i++ {
+		msg = WriterMessage{}
+		if err := conn.ReadJSON(&msg); err != nil {
+			t.Fatal(err)
+		}
+		switch msg.Type {
+		case "frame":
+			if msg.Frame.Values["Speed"] != float64(msg.Frame.TickCount) || len(msg.Frame.Values) != 1 {
+				t.Errorf("unexpected frame %+v", msg.Frame)
+			}
+			ticks = append(ticks, msg.Frame.TickCount)
+		case "session":
+			session = msg.SessionInfo == "---\n"
+		case "event":
+			event = msg.Event.CarIdx == 4
+		}
+	}
+	if len(ticks) != 2 || ticks[0] != 1 || ticks[1] != 3 || !session || !event {
+		t.Errorf("got frames of ticks %v, session %v, event %v", ticks, session, event)
+	}
+
+	// the connection closes when the reader stops
+	client.unsubscribeAll()
+	if _, _, err := conn.ReadMessage(); !websocket.IsCloseError(err, websocket.CloseGoingAway) {
+		t.Errorf("got %v want going away close", err)
+	}
+}
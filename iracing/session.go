@@ -0,0 +1,339 @@
+package iracing
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+// Session is the session info yaml written by the sim parsed into go types.
+// Values the sim writes with units such as TrackLength "7.00 km" are kept as strings.
+// Keys not modeled here are ignored so new sim builds can add them without breaking parsing.
+type Session struct {
+	WeekendInfo        WeekendInfo            `yaml:"WeekendInfo"`
+	SessionInfo        SessionInfo            `yaml:"SessionInfo"`
+	QualifyResultsInfo QualifyResultsInfo     `yaml:"QualifyResultsInfo"`
+	CameraInfo         CameraInfo             `yaml:"CameraInfo"`
+	RadioInfo          RadioInfo              `yaml:"RadioInfo"`
+	DriverInfo         DriverInfo             `yaml:"DriverInfo"`
+	SplitTimeInfo      SplitTimeInfo          `yaml:"SplitTimeInfo"`
+	CarSetup           map[string]interface{} `yaml:"CarSetup"` // car specific, differs for every car
+}
+
+// WeekendInfo describes the track, series and weekend options of the event
+type WeekendInfo struct {
+	TrackName              string           `yaml:"TrackName"`
+	TrackID                int              `yaml:"TrackID"`
+	TrackLength            string           `yaml:"TrackLength"`
+	TrackDisplayName       string           `yaml:"TrackDisplayName"`
+	TrackDisplayShortName  string           `yaml:"TrackDisplayShortName"`
+	TrackConfigName        string           `yaml:"TrackConfigName"`
+	TrackCity              string           `yaml:"TrackCity"`
+	TrackCountry           string           `yaml:"TrackCountry"`
+	TrackAltitude          string           `yaml:"TrackAltitude"`
+	TrackLatitude          string           `yaml:"TrackLatitude"`
+	TrackLongitude         string           `yaml:"TrackLongitude"`
+	TrackNorthOffset       string           `yaml:"TrackNorthOffset"`
+	TrackNumTurns          int              `yaml:"TrackNumTurns"`
+	TrackPitSpeedLimit     string           `yaml:"TrackPitSpeedLimit"`
+	TrackType              string           `yaml:"TrackType"`
+	TrackDirection         string           `yaml:"TrackDirection"`
+	TrackWeatherType       string           `yaml:"TrackWeatherType"`
+	TrackSkies             string           `yaml:"TrackSkies"`
+	TrackSurfaceTemp       string           `yaml:"TrackSurfaceTemp"`
+	TrackAirTemp           string           `yaml:"TrackAirTemp"`
+	TrackAirPressure       string           `yaml:"TrackAirPressure"`
+	TrackWindVel           string           `yaml:"TrackWindVel"`
+	TrackWindDir           string           `yaml:"TrackWindDir"`
+	TrackRelativeHumidity  string           `yaml:"TrackRelativeHumidity"`
+	TrackFogLevel          string           `yaml:"TrackFogLevel"`
+	TrackCleanup           int              `yaml:"TrackCleanup"`
+	TrackDynamicTrack      int              `yaml:"TrackDynamicTrack"`
+	TrackVersion           string           `yaml:"TrackVersion"`
+	SeriesID               int              `yaml:"SeriesID"`
+	SeasonID               int              `yaml:"SeasonID"`
+	SessionID              int              `yaml:"SessionID"`
+	SubSessionID           int              `yaml:"SubSessionID"`
+	LeagueID               int              `yaml:"LeagueID"`
+	Official               int              `yaml:"Official"`
+	RaceWeek               int              `yaml:"RaceWeek"`
+	EventType              string           `yaml:"EventType"`
+	Category               string           `yaml:"Category"`
+	SimMode                string           `yaml:"SimMode"`
+	TeamRacing             int              `yaml:"TeamRacing"`
+	MinDrivers             int              `yaml:"MinDrivers"`
+	MaxDrivers             int              `yaml:"MaxDrivers"`
+	DCRuleSet              string           `yaml:"DCRuleSet"`
+	QualifierMustStartRace int              `yaml:"QualifierMustStartRace"`
+	NumCarClasses          int              `yaml:"NumCarClasses"`
+	NumCarTypes            int              `yaml:"NumCarTypes"`
+	HeatRacing             int              `yaml:"HeatRacing"`
+	BuildType              string           `yaml:"BuildType"`
+	BuildTarget            string           `yaml:"BuildTarget"`
+	BuildVersion           string           `yaml:"BuildVersion"`
+	WeekendOptions         WeekendOptions   `yaml:"WeekendOptions"`
+	TelemetryOptions       TelemetryOptions `yaml:"TelemetryOptions"`
+}
+
+// WeekendOptions are the options the event was set up with
+type WeekendOptions struct {
+	NumStarters                int    `yaml:"NumStarters"`
+	StartingGrid               string `yaml:"StartingGrid"`
+	QualifyScoring             string `yaml:"QualifyScoring"`
+	CourseCautions             string `yaml:"CourseCautions"`
+	StandingStart              int    `yaml:"StandingStart"`
+	ShortParadeLap             int    `yaml:"ShortParadeLap"`
+	Restarts                   string `yaml:"Restarts"`
+	WeatherType                string `yaml:"WeatherType"`
+	Skies                      string `yaml:"Skies"`
+	WindDirection              string `yaml:"WindDirection"`
+	WindSpeed                  string `yaml:"WindSpeed"`
+	WeatherTemp                string `yaml:"WeatherTemp"`
+	RelativeHumidity           string `yaml:"RelativeHumidity"`
+	FogLevel                   string `yaml:"FogLevel"`
+	TimeOfDay                  string `yaml:"TimeOfDay"`
+	Date                       string `yaml:"Date"`
+	EarthRotationSpeedupFactor int    `yaml:"EarthRotationSpeedupFactor"`
+	Unofficial                 int    `yaml:"Unofficial"`
+	CommercialMode             string `yaml:"CommercialMode"`
+	NightMode                  string `yaml:"NightMode"`
+	IsFixedSetup               int    `yaml:"IsFixedSetup"`
+	StrictLapsChecking         string `yaml:"StrictLapsChecking"`
+	HasOpenRegistration        int    `yaml:"HasOpenRegistration"`
+	HardcoreLevel              int    `yaml:"HardcoreLevel"`
+	NumJokerLaps               int    `yaml:"NumJokerLaps"`
+	IncidentLimit              string `yaml:"IncidentLimit"`
+	FastRepairsLimit           string `yaml:"FastRepairsLimit"`
+	GreenWhiteCheckeredLimit   int    `yaml:"GreenWhiteCheckeredLimit"`
+}
+
+// TelemetryOptions are the sim disk telemetry options
+type TelemetryOptions struct {
+	TelemetryDiskFile string `yaml:"TelemetryDiskFile"`
+}
+
+// SessionInfo lists the sessions of the event, e.g. practice, qualify and race
+type SessionInfo struct {
+	Sessions []SessionDetail `yaml:"Sessions"`
+}
+
+// SessionDetail describes a session and its results
+type SessionDetail struct {
+	SessionNum              int               `yaml:"SessionNum"`
+	SessionLaps             string            `yaml:"SessionLaps"` // number of laps or unlimited
+	SessionTime             string            `yaml:"SessionTime"` // e.g. 600.0000 sec or unlimited
+	SessionNumLapsToAvg     int               `yaml:"SessionNumLapsToAvg"`
+	SessionType             string            `yaml:"SessionType"`
+	SessionTrackRubberState string            `yaml:"SessionTrackRubberState"`
+	SessionName             string            `yaml:"SessionName"`
+	SessionSubType          string            `yaml:"SessionSubType"`
+	SessionSkipped          int               `yaml:"SessionSkipped"`
+	SessionRunGroupsUsed    int               `yaml:"SessionRunGroupsUsed"`
+	ResultsPositions        []ResultsPosition `yaml:"ResultsPositions"`
+	ResultsFastestLap       []FastestLap      `yaml:"ResultsFastestLap"`
+	ResultsAverageLapTime   float64           `yaml:"ResultsAverageLapTime"`
+	ResultsNumCautionFlags  int               `yaml:"ResultsNumCautionFlags"`
+	ResultsNumCautionLaps   int               `yaml:"ResultsNumCautionLaps"`
+	ResultsNumLeadChanges   int               `yaml:"ResultsNumLeadChanges"`
+	ResultsLapsComplete     int               `yaml:"ResultsLapsComplete"`
+	ResultsOfficial         int               `yaml:"ResultsOfficial"`
+}
+
+// ResultsPosition is the result of a car in a session
+type ResultsPosition struct {
+	Position          int     `yaml:"Position"`
+	ClassPosition     int     `yaml:"ClassPosition"`
+	CarIdx            int     `yaml:"CarIdx"`
+	Lap               int     `yaml:"Lap"`
+	Time              float64 `yaml:"Time"`
+	FastestLap        int     `yaml:"FastestLap"`
+	FastestTime       float64 `yaml:"FastestTime"`
+	LastTime          float64 `yaml:"LastTime"`
+	LapsLed           int     `yaml:"LapsLed"`
+	LapsComplete      int     `yaml:"LapsComplete"`
+	JokerLapsComplete int     `yaml:"JokerLapsComplete"`
+	LapsDriven        float64 `yaml:"LapsDriven"`
+	Incidents         int     `yaml:"Incidents"`
+	ReasonOutID       int     `yaml:"ReasonOutId"`
+	ReasonOutStr      string  `yaml:"ReasonOutStr"`
+}
+
+// FastestLap is the fastest lap of a car in a session
+type FastestLap struct {
+	CarIdx      int     `yaml:"CarIdx"`
+	FastestLap  int     `yaml:"FastestLap"`
+	FastestTime float64 `yaml:"FastestTime"`
+}
+
+// QualifyResultsInfo lists the qualifying results used to set the grid
+type QualifyResultsInfo struct {
+	Results []QualifyResult `yaml:"Results"`
+}
+
+// QualifyResult is the qualifying result of a car
+type QualifyResult struct {
+	Position      int     `yaml:"Position"`
+	ClassPosition int     `yaml:"ClassPosition"`
+	CarIdx        int     `yaml:"CarIdx"`
+	FastestLap    int     `yaml:"FastestLap"`
+	FastestTime   float64 `yaml:"FastestTime"`
+}
+
+// CameraInfo lists the camera groups available in the sim
+type CameraInfo struct {
+	Groups []CameraGroup `yaml:"Groups"`
+}
+
+// CameraGroup is a group of cameras e.g. Nose or Cockpit
+type CameraGroup struct {
+	GroupNum  int      `yaml:"GroupNum"`
+	GroupName string   `yaml:"GroupName"`
+	IsScenic  bool     `yaml:"IsScenic"`
+	Cameras   []Camera `yaml:"Cameras"`
+}
+
+// Camera is a camera of a group
+type Camera struct {
+	CameraNum  int    `yaml:"CameraNum"`
+	CameraName string `yaml:"CameraName"`
+}
+
+// RadioInfo lists the radios and the frequencies they can tune to
+type RadioInfo struct {
+	SelectedRadioNum int     `yaml:"SelectedRadioNum"`
+	Radios           []Radio `yaml:"Radios"`
+}
+
+// Radio is a radio of the player
+type Radio struct {
+	RadioNum            int         `yaml:"RadioNum"`
+	HopCount            int         `yaml:"HopCount"`
+	NumFrequencies      int         `yaml:"NumFrequencies"`
+	TunedToFrequencyNum int         `yaml:"TunedToFrequencyNum"`
+	ScanningIsOn        int         `yaml:"ScanningIsOn"`
+	Frequencies         []Frequency `yaml:"Frequencies"`
+}
+
+// Frequency is a radio frequency e.g. @ALLTEAMS or a team channel
+type Frequency struct {
+	FrequencyNum  int    `yaml:"FrequencyNum"`
+	FrequencyName string `yaml:"FrequencyName"`
+	Priority      int    `yaml:"Priority"`
+	CarIdx        int    `yaml:"CarIdx"`
+	EntryIdx      int    `yaml:"EntryIdx"`
+	ClubID        int    `yaml:"ClubID"`
+	CanScan       int    `yaml:"CanScan"`
+	CanSquawk     int    `yaml:"CanSquawk"`
+	Muted         int    `yaml:"Muted"`
+	IsMutable     int    `yaml:"IsMutable"`
+	IsDeletable   int    `yaml:"IsDeletable"`
+}
+
+// DriverInfo describes the player car and lists every driver in the session
+type DriverInfo struct {
+	DriverCarIdx              int      `yaml:"DriverCarIdx"`
+	DriverUserID              int      `yaml:"DriverUserID"`
+	PaceCarIdx                int      `yaml:"PaceCarIdx"`
+	DriverHeadPosX            float64  `yaml:"DriverHeadPosX"`
+	DriverHeadPosY            float64  `yaml:"DriverHeadPosY"`
+	DriverHeadPosZ            float64  `yaml:"DriverHeadPosZ"`
+	DriverIsAdmin             int      `yaml:"DriverIsAdmin"`
+	DriverCarIdleRPM          float64  `yaml:"DriverCarIdleRPM"`
+	DriverCarRedLine          float64  `yaml:"DriverCarRedLine"`
+	DriverCarEngCylinderCount int      `yaml:"DriverCarEngCylinderCount"`
+	DriverCarFuelKgPerLtr     float64  `yaml:"DriverCarFuelKgPerLtr"`
+	DriverCarFuelMaxLtr       float64  `yaml:"DriverCarFuelMaxLtr"`
+	DriverCarMaxFuelPct       float64  `yaml:"DriverCarMaxFuelPct"`
+	DriverCarGearNumForward   int      `yaml:"DriverCarGearNumForward"`
+	DriverCarGearNeutral      int      `yaml:"DriverCarGearNeutral"`
+	DriverCarGearReverse      int      `yaml:"DriverCarGearReverse"`
+	DriverCarSLFirstRPM       float64  `yaml:"DriverCarSLFirstRPM"`
+	DriverCarSLShiftRPM       float64  `yaml:"DriverCarSLShiftRPM"`
+	DriverCarSLLastRPM        float64  `yaml:"DriverCarSLLastRPM"`
+	DriverCarSLBlinkRPM       float64  `yaml:"DriverCarSLBlinkRPM"`
+	DriverCarVersion          string   `yaml:"DriverCarVersion"`
+	DriverPitTrkPct           float64  `yaml:"DriverPitTrkPct"`
+	DriverCarEstLapTime       float64  `yaml:"DriverCarEstLapTime"`
+	DriverSetupName           string   `yaml:"DriverSetupName"`
+	DriverSetupIsModified     int      `yaml:"DriverSetupIsModified"`
+	DriverSetupLoadTypeName   string   `yaml:"DriverSetupLoadTypeName"`
+	DriverSetupPassedTech     int      `yaml:"DriverSetupPassedTech"`
+	DriverIncidentCount       int      `yaml:"DriverIncidentCount"`
+	Drivers                   []Driver `yaml:"Drivers"`
+}
+
+// Driver is a driver and car in the session. For team events the driver is the one currently in the car.
+type Driver struct {
+	CarIdx                  int     `yaml:"CarIdx"`
+	UserName                string  `yaml:"UserName"`
+	AbbrevName              string  `yaml:"AbbrevName"`
+	Initials                string  `yaml:"Initials"`
+	UserID                  int     `yaml:"UserID"`
+	TeamID                  int     `yaml:"TeamID"`
+	TeamName                string  `yaml:"TeamName"`
+	CarNumber               string  `yaml:"CarNumber"`
+	CarNumberRaw            int     `yaml:"CarNumberRaw"`
+	CarPath                 string  `yaml:"CarPath"`
+	CarClassID              int     `yaml:"CarClassID"`
+	CarID                   int     `yaml:"CarID"`
+	CarIsPaceCar            int     `yaml:"CarIsPaceCar"`
+	CarIsAI                 int     `yaml:"CarIsAI"`
+	CarScreenName           string  `yaml:"CarScreenName"`
+	CarScreenNameShort      string  `yaml:"CarScreenNameShort"`
+	CarClassShortName       string  `yaml:"CarClassShortName"`
+	CarClassRelSpeed        int     `yaml:"CarClassRelSpeed"`
+	CarClassLicenseLevel    int     `yaml:"CarClassLicenseLevel"`
+	CarClassMaxFuelPct      string  `yaml:"CarClassMaxFuelPct"`
+	CarClassWeightPenalty   string  `yaml:"CarClassWeightPenalty"`
+	CarClassPowerAdjust     string  `yaml:"CarClassPowerAdjust"`
+	CarClassDryTireSetLimit string  `yaml:"CarClassDryTireSetLimit"`
+	CarClassColor           string  `yaml:"CarClassColor"`
+	CarClassEstLapTime      float64 `yaml:"CarClassEstLapTime"`
+	IRating                 int     `yaml:"IRating"`
+	LicLevel                int     `yaml:"LicLevel"`
+	LicSubLevel             int     `yaml:"LicSubLevel"`
+	LicString               string  `yaml:"LicString"`
+	LicColor                string  `yaml:"LicColor"`
+	IsSpectator             int     `yaml:"IsSpectator"`
+	CarDesignStr            string  `yaml:"CarDesignStr"`
+	HelmetDesignStr         string  `yaml:"HelmetDesignStr"`
+	SuitDesignStr           string  `yaml:"SuitDesignStr"`
+	CarNumberDesignStr      string  `yaml:"CarNumberDesignStr"`
+	CarSponsor1             int     `yaml:"CarSponsor_1"`
+	CarSponsor2             int     `yaml:"CarSponsor_2"`
+	CurDriverIncidentCount  int     `yaml:"CurDriverIncidentCount"`
+	TeamIncidentCount       int     `yaml:"TeamIncidentCount"`
+}
+
+// SplitTimeInfo lists the sectors of the track
+type SplitTimeInfo struct {
+	Sectors []Sector `yaml:"Sectors"`
+}
+
+// Sector is a track sector starting at a percentage of the lap
+type Sector struct {
+	SectorNum      int     `yaml:"SectorNum"`
+	SectorStartPct float64 `yaml:"SectorStartPct"`
+}
+
+// ParseSessionInfo parses the session info yaml written by the sim after repairing its known quirks.
+// If values do not match the type of their field the session is returned with the other values
+// set along with a *yaml.TypeError listing the mismatches.
+func ParseSessionInfo(sessionInfoYaml string) (*Session, error) {
+	s := &Session{}
+	if err := yaml.Unmarshal([]byte(sanitizeSessionYaml(sessionInfoYaml)), s); err != nil {
+		if _, ok := err.(*yaml.TypeError); ok {
+			return s, err
+		}
+		return nil, err
+	}
+	return s, nil
+}
+
+// Driver returns the driver in carIdx or nil if there is no driver for the car
+func (s *Session) Driver(carIdx int) *Driver {
+	for i := range s.DriverInfo.Drivers {
+		if s.DriverInfo.Drivers[i].CarIdx == carIdx {
+			return &s.DriverInfo.Drivers[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package iracing
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+)
+
+// decodeSessionString returns the nul terminated ISO-8859-1 session info in b as a UTF-8 string.
+// Every ISO-8859-1 byte is the unicode code point of the same value.
+func decodeSessionString(b []byte) string {
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		b = b[:i]
+	}
+	var sb strings.Builder
+	sb.Grow(len(b))
+	for _, c := range b {
+		sb.WriteRune(rune(c))
+	}
+	return sb.String()
+}
+
+// encodeSessionString returns the UTF-8 session info s as ISO-8859-1 bytes for writing as the sim does.
+// Characters outside of ISO-8859-1 are replaced with ?.
+func encodeSessionString(s string) []byte {
+	b := make([]byte, 0, len(s))
+	for _, r := range s {
+		if r > 0xff {
+			r = '?'
+		}
+		b = append(b, byte(r))
+	}
+	return b
+}
+
+// sessionKeyValue matches a key value line of the session info yaml including list items
+var sessionKeyValue = regexp.MustCompile(`^(\s*(?:- )?[A-Za-z0-9_]+: )(.+?)\s*$`)
+
+// sanitizeSessionYaml repairs the known quirks of the yaml written by the sim so standard parsers accept it.
+// The sim writes user entered values such as driver, team and setup names unquoted so values
+// with a colon, a comment marker or starting with a yaml indicator like * are quoted.
+func sanitizeSessionYaml(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		m := sessionKeyValue.FindStringSubmatch(line)
+		if m == nil || !needsQuoting(m[2]) {
+			continue
+		}
+		lines[i] = m[1] + quoteYaml(m[2])
+	}
+	return strings.Join(lines, "\n")
+}
+
+// needsQuoting reports whether the plain yaml scalar v would not parse as the string v
+func needsQuoting(v string) bool {
+	switch v[0] {
+	case '"', '\'':
+		// already quoted by the sim
+		return !strings.HasSuffix(v, v[:1]) || len(v) == 1
+	case '*', '&', '!', '|', '>', '%', '@', '`', '{', '[', '#', ',', '?', '-', ':':
+		return v != "-" && !isNumber(v)
+	}
+	return strings.Contains(v, ": ") || strings.Contains(v, " #") || strings.HasSuffix(v, ":") || strings.Contains(v, "\t")
+}
+
+// isNumber reports whether v is a plain number such as -1 or -0.5000
+func isNumber(v string) bool {
+	v = strings.TrimPrefix(v, "-")
+	if v == "" {
+		return false
+	}
+	for _, c := range v {
+		if (c < '0' || c > '9') && c != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+// quoteYaml returns v as a double quoted yaml scalar
+func quoteYaml(v string) string {
+	v = strings.ReplaceAll(v, `\`, `\\`)
+	v = strings.ReplaceAll(v, `"`, `\"`)
+	v = strings.ReplaceAll(v, "\t", `\t`)
+	return `"` + v + `"`
+}
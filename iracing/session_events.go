@@ -0,0 +1,186 @@
+package iracing
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// sessionEventBuffer is the number of session events buffered for SessionEvents before new ones are dropped
+const sessionEventBuffer = 64
+
+// SessionEventType is the kind of change a SessionEvent describes
+type SessionEventType int
+
+const (
+	// DriverJoined a car joined the session
+	DriverJoined SessionEventType = iota
+	// DriverLeft a car left the session
+	DriverLeft
+	// DriverSwap a different driver took over a car
+	DriverSwap
+	// ResultsUpdated the results positions of a session changed
+	ResultsUpdated
+	// SessionNumChanged the sim moved on to another session of the event
+	SessionNumChanged
+	// WeatherChanged the track weather changed
+	WeatherChanged
+	// MarkerAdded a named marker was added with Mark
+	MarkerAdded
+)
+
+func (t SessionEventType) String() string {
+	return [...]string{"driver joined", "driver left", "driver swap", "results updated", "session number changed", "weather changed", "marker added"}[t]
+}
+
+// MarshalText encodes the event type as its name
+func (t SessionEventType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText decodes the event type from its name
+func (t *SessionEventType) UnmarshalText(b []byte) error {
+	for et := DriverJoined; et <= MarkerAdded; et++ {
+		if et.String() == string(b) {
+			*t = et
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown session event type %s", b)
+}
+
+// SessionEvent is a structured change between two reads of the session info
+type SessionEvent struct {
+	Type               SessionEventType
+	Time               time.Time
+	CarIdx             int               // car of driver events
+	Driver             *Driver           // driver that joined, left or took over the car
+	PreviousDriver     *Driver           // driver swapped out of the car
+	SessionNum         int               // session of results and session number events
+	PreviousSessionNum int               // session before a session number change
+	Positions          []ResultsPosition // results positions of SessionNum after an update
+	Changes            []FieldChange     // changed fields of a weather event
+	Marker             *Marker           // marker of a marker event
+}
+
+// FieldChange is a session info field that changed value
+type FieldChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+// SessionEvents returns a channel receiving the changes found each time the session info is re-read.
+// Events are dropped if the channel is not read and its buffer fills. The events are also
+// returned with the DataUpdate of the read.
+func (ir *Client) SessionEvents() <-chan SessionEvent {
+	return ir.sessionEvents
+}
+
+// emitSessionEvents queues events for the next DataUpdate and sends them on the session events channel
+func (ir *Client) emitSessionEvents(events []SessionEvent) {
+	for _, e := range events {
+		ir.logger.Info("iracing session changed", zap.Stringer("type", e.Type), zap.Int("carIdx", e.CarIdx), zap.Int("sessionNum", e.SessionNum))
+		ir.eventLock.Lock()
+		ir.pendingEvents = append(ir.pendingEvents, e)
+		ir.eventLock.Unlock()
+		select {
+		case ir.sessionEvents <- e:
+		default:
+			ir.logger.Warn("session event dropped, session events channel full", zap.Stringer("type", e.Type))
+		}
+	}
+}
+
+// checkSessionNum emits a SessionNumChanged event when the SessionNum variable of the newest sample changes
+func (ir *Client) checkSessionNum() {
+	num, err := ir.Sample().Int("SessionNum")
+	if err != nil {
+		return
+	}
+	if ir.sessionNumRead && int(num) != ir.sessionNum {
+		ir.emitSessionEvents([]SessionEvent{{
+			Type:               SessionNumChanged,
+			Time:               time.Now(),
+			CarIdx:             -1,
+			SessionNum:         int(num),
+			PreviousSessionNum: ir.sessionNum,
+		}})
+	}
+	ir.sessionNum = int(num)
+	ir.sessionNumRead = true
+}
+
+// diffSession returns the driver, results and weather changes from prev to cur.
+// The session number is not part of the session info, it is followed with the SessionNum variable.
+func diffSession(prev, cur *Session) []SessionEvent {
+	if prev == nil || cur == nil {
+		return nil
+	}
+	now := time.Now()
+	var events []SessionEvent
+
+	for i := range cur.DriverInfo.Drivers {
+		d := &cur.DriverInfo.Drivers[i]
+		old := prev.Driver(d.CarIdx)
+		switch {
+		case old == nil:
+			events = append(events, SessionEvent{Type: DriverJoined, Time: now, CarIdx: d.CarIdx, Driver: d})
+		case old.UserID != d.UserID:
+			events = append(events, SessionEvent{Type: DriverSwap, Time: now, CarIdx: d.CarIdx, Driver: d, PreviousDriver: old})
+		}
+	}
+	for i := range prev.DriverInfo.Drivers {
+		d := &prev.DriverInfo.Drivers[i]
+		if cur.Driver(d.CarIdx) == nil {
+			events = append(events, SessionEvent{Type: DriverLeft, Time: now, CarIdx: d.CarIdx, Driver: d})
+		}
+	}
+
+	for _, s := range cur.SessionInfo.Sessions {
+		var old []ResultsPosition
+		for _, p := range prev.SessionInfo.Sessions {
+			if p.SessionNum == s.SessionNum {
+				old = p.ResultsPositions
+				break
+			}
+		}
+		if len(old) == 0 && len(s.ResultsPositions) == 0 || reflect.DeepEqual(old, s.ResultsPositions) {
+			continue
+		}
+		events = append(events, SessionEvent{Type: ResultsUpdated, Time: now, CarIdx: -1, SessionNum: s.SessionNum, Positions: s.ResultsPositions})
+	}
+
+	if changes := diffWeather(&prev.WeekendInfo, &cur.WeekendInfo); len(changes) > 0 {
+		events = append(events, SessionEvent{Type: WeatherChanged, Time: now, CarIdx: -1, Changes: changes})
+	}
+	return events
+}
+
+// weatherFields are the WeekendInfo fields describing the track weather
+var weatherFields = []string{
+	"TrackWeatherType",
+	"TrackSkies",
+	"TrackSurfaceTemp",
+	"TrackAirTemp",
+	"TrackAirPressure",
+	"TrackWindVel",
+	"TrackWindDir",
+	"TrackRelativeHumidity",
+	"TrackFogLevel",
+}
+
+func diffWeather(prev, cur *WeekendInfo) []FieldChange {
+	var changes []FieldChange
+	p, c := reflect.ValueOf(prev).Elem(), reflect.ValueOf(cur).Elem()
+	for _, name := range weatherFields {
+		from := fmt.Sprint(p.FieldByName(name).Interface())
+		to := fmt.Sprint(c.FieldByName(name).Interface())
+		if from != to {
+			changes = append(changes, FieldChange{Field: name, From: from, To: to})
+		}
+	}
+	return changes
+}
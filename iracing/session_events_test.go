@@ -0,0 +1,58 @@
+package iracing
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+func TestDiffSession(t *testing.T) {
+	b, err := ioutil.ReadFile("testdata/session_basic.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	prev, err := ParseSessionInfo(string(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if events := diffSession(nil, prev); len(events) != 0 {
+		t.Errorf("expected no events for the first session got %+v", events)
+	}
+	cur, _ := ParseSessionInfo(string(b))
+	if events := diffSession(prev, cur); len(events) != 0 {
+		t.Errorf("expected no events for the same session got %+v", events)
+	}
+
+	// car 0 swaps driver, car 1 joins, the results and the skies change
+	cur.DriverInfo.Drivers[0].UserID = 654321
+	cur.DriverInfo.Drivers = append(cur.DriverInfo.Drivers, Driver{CarIdx: 1, UserName: "New Driver"})
+	cur.SessionInfo.Sessions[0].ResultsPositions[0].Lap = 4
+	cur.WeekendInfo.TrackSkies = "Overcast"
+
+	events := diffSession(prev, cur)
+	want := []SessionEventType{DriverSwap, DriverJoined, ResultsUpdated, WeatherChanged}
+	if len(events) != len(want) {
+		t.Fatalf("got events %+v want %v", events, want)
+	}
+	for i, e := range events {
+		if e.Type != want[i] {
+			t.Errorf("event %d got %v want %v", i, e.Type, want[i])
+		}
+	}
+	if e := events[0]; e.CarIdx != 0 || e.Driver.UserID != 654321 || e.PreviousDriver.UserID != 123456 {
+		t.Errorf("unexpected swap %+v", e)
+	}
+	if e := events[1]; e.CarIdx != 1 || e.Driver.UserName != "New Driver" {
+		t.Errorf("unexpected join %+v", e)
+	}
+	if e := events[2]; e.SessionNum != 0 || e.Positions[0].Lap != 4 {
+		t.Errorf("unexpected results %+v", e)
+	}
+	if c := events[3].Changes; len(c) != 1 || c[0] != (FieldChange{"TrackSkies", "Partly Cloudy", "Overcast"}) {
+		t.Errorf("unexpected weather changes %+v", c)
+	}
+
+	// car 1 leaves
+	if events := diffSession(cur, prev); len(events) < 2 || events[1].Type != DriverLeft || events[1].CarIdx != 1 {
+		t.Errorf("expected car 1 to leave got %+v", events)
+	}
+}
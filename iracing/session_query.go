@@ -0,0 +1,160 @@
+package iracing
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrSessionPathNotFound is returned when a session info query path does not match the session info
+var ErrSessionPathNotFound = errors.New("session info path not found")
+
+// sessionTree is the session info yaml parsed into maps, lists and scalars for path queries
+type sessionTree map[string]interface{}
+
+// parseSessionTree parses the session info yaml after repairing its known quirks
+func parseSessionTree(sessionInfoYaml string) (sessionTree, error) {
+	// decode into a plain map, yaml.v3 decodes nested maps as the type of the outer map
+	tree := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(sanitizeSessionYaml(sessionInfoYaml)), &tree); err != nil {
+		return nil, err
+	}
+	return sessionTree(tree), nil
+}
+
+// sessionPathPart is a key of a session query path with the optional {n} selector that follows it
+type sessionPathPart struct {
+	key      string
+	selector string
+	selected bool
+}
+
+// parseSessionPath splits an irsdk session info path such as
+// SessionInfo:Sessions:SessionNum:{2}ResultsPositions:Position:{1}CarIdx: into its keys and selectors
+func parseSessionPath(path string) ([]sessionPathPart, error) {
+	var parts []sessionPathPart
+	for rest := path; rest != ""; {
+		i := strings.IndexByte(rest, ':')
+		if i < 0 {
+			// allow the trailing colon to be left off
+			i = len(rest)
+		}
+		part := sessionPathPart{key: rest[:i]}
+		if part.key == "" || strings.ContainsAny(part.key, "{}") {
+			return nil, fmt.Errorf("invalid session info path %s", path)
+		}
+		rest = rest[min(i+1, len(rest)):]
+		if strings.HasPrefix(rest, "{") {
+			end := strings.IndexByte(rest, '}')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid session info path %s: unterminated selector", path)
+			}
+			part.selector = rest[1:end]
+			part.selected = true
+			rest = rest[end+1:]
+		}
+		parts = append(parts, part)
+	}
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("empty session info path")
+	}
+	return parts, nil
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// query returns the value at an irsdk session info path. A key followed by a {n} selector picks
+// the list item whose value for the key is n, e.g. DriverInfo:Drivers:CarIdx:{3}UserName: is the
+// user name of the driver of car 3. A key on a list without a selector reads the first item like irsdk.
+func (tree sessionTree) query(path string) (interface{}, error) {
+	parts, err := parseSessionPath(path)
+	if err != nil {
+		return nil, err
+	}
+	var node interface{} = map[string]interface{}(tree)
+	for i, part := range parts {
+		walked := func() string {
+			return sessionPathString(parts[:i+1])
+		}
+		if part.selected {
+			list, ok := node.([]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%w: %s is not a list", ErrSessionPathNotFound, walked())
+			}
+			node = nil
+			for _, item := range list {
+				if m, ok := item.(map[string]interface{}); ok && fmt.Sprint(m[part.key]) == part.selector {
+					node = m
+					break
+				}
+			}
+			if node == nil {
+				return nil, fmt.Errorf("%w: no item with %s %s at %s", ErrSessionPathNotFound, part.key, part.selector, walked())
+			}
+			continue
+		}
+
+		if list, ok := node.([]interface{}); ok && len(list) > 0 {
+			node = list[0]
+		}
+		m, ok := node.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrSessionPathNotFound, walked())
+		}
+		if node, ok = m[part.key]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrSessionPathNotFound, walked())
+		}
+	}
+	return node, nil
+}
+
+// sessionPathString formats path parts back into an irsdk session info path
+func sessionPathString(parts []sessionPathPart) string {
+	var sb strings.Builder
+	for _, p := range parts {
+		if p.selected {
+			fmt.Fprintf(&sb, "%s:{%s}", p.key, p.selector)
+			continue
+		}
+		sb.WriteString(p.key + ":")
+	}
+	return sb.String()
+}
+
+// QuerySessionInfo returns the value at an irsdk session info path such as
+// SessionInfo:Sessions:SessionNum:{2}ResultsPositions:Position:{1}CarIdx: in the session info yaml.
+// Values are returned as their yaml type, int, float64, bool or string for scalars or
+// map[string]interface{} and []interface{} for sections. An error wrapping ErrSessionPathNotFound
+// is returned if the path is missing.
+func QuerySessionInfo(sessionInfoYaml, path string) (interface{}, error) {
+	tree, err := parseSessionTree(sessionInfoYaml)
+	if err != nil {
+		return nil, err
+	}
+	return tree.query(path)
+}
+
+// QuerySession returns the value at an irsdk session info path in the most recently read session info.
+// See QuerySessionInfo for the path syntax and returned types.
+func (ir *Client) QuerySession(path string) (interface{}, error) {
+	ir.sessionLock.Lock()
+	tree := ir.sessionTree
+	ir.sessionLock.Unlock()
+	if tree == nil {
+		return nil, fmt.Errorf("no session info read")
+	}
+	return tree.query(path)
+}
+
+// QuerySession returns the value at an irsdk session info path in the session info of the file.
+// See QuerySessionInfo for the path syntax and returned types.
+func (ibt *IBTReader) QuerySession(path string) (interface{}, error) {
+	return QuerySessionInfo(ibt.SessionInfoYaml, path)
+}
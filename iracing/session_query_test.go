@@ -0,0 +1,54 @@
+package iracing
+
+import (
+	"errors"
+	"io/ioutil"
+	"testing"
+)
+
+func TestQuerySessionInfo(t *testing.T) {
+	b, err := ioutil.ReadFile("testdata/session_basic.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		path string
+		want interface{}
+	}{
+		{"WeekendInfo:TrackName:", "spa 2015 gp"},
+		{"WeekendInfo:TrackID", 163},
+		{"SessionInfo:Sessions:SessionNum:{0}ResultsPositions:Position:{1}CarIdx:", 0},
+		{"SessionInfo:Sessions:SessionNum:{0}ResultsPositions:Position:{1}FastestTime:", 139.4823},
+		{"DriverInfo:Drivers:CarIdx:{0}UserName:", "Max Racer"},
+		{"DriverInfo:Drivers:CarIdx:{0}CarNumber:", "25"},
+		{"SplitTimeInfo:Sectors:SectorNum:{1}SectorStartPct:", 0.32914},
+		// no selector reads the first list item
+		{"SplitTimeInfo:Sectors:SectorStartPct:", 0.0},
+	}
+	for _, tc := range tests {
+		got, err := QuerySessionInfo(string(b), tc.path)
+		if err != nil {
+			t.Errorf("%s: %v", tc.path, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("%s: got %v (%T) want %v (%T)", tc.path, got, got, tc.want, tc.want)
+		}
+	}
+
+	for _, path := range []string{
+		"WeekendInfo:Nope:",
+		"DriverInfo:Drivers:CarIdx:{5}UserName:",
+		"WeekendInfo:TrackName:{1}Nope:",
+		"SessionInfo:Sessions:SessionNum:{0}ResultsPositions:Position:{1}CarIdx:Nope:",
+	} {
+		if _, err := QuerySessionInfo(string(b), path); !errors.Is(err, ErrSessionPathNotFound) {
+			t.Errorf("%s: expected ErrSessionPathNotFound got %v", path, err)
+		}
+	}
+	for _, path := range []string{"", "WeekendInfo::", "DriverInfo:Drivers:CarIdx:{0"} {
+		if _, err := QuerySessionInfo(string(b), path); err == nil || errors.Is(err, ErrSessionPathNotFound) {
+			t.Errorf("%q: expected invalid path error got %v", path, err)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package iracing
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+)
+
+func TestParseSessionInfo(t *testing.T) {
+	b, err := ioutil.ReadFile("testdata/session_basic.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := ParseSessionInfo(string(b))
+	if err != nil {
+		t.Fatalf("ParseSessionInfo: %v", err)
+	}
+
+	if s.WeekendInfo.TrackID != 163 || s.WeekendInfo.TrackLength != "6.93 km" || s.WeekendInfo.WeekendOptions.IncidentLimit != "unlimited" {
+		t.Errorf("unexpected weekend info %+v", s.WeekendInfo)
+	}
+	if len(s.SessionInfo.Sessions) != 1 || s.SessionInfo.Sessions[0].SessionLaps != "unlimited" {
+		t.Fatalf("unexpected sessions %+v", s.SessionInfo.Sessions)
+	}
+	if p := s.SessionInfo.Sessions[0].ResultsPositions; len(p) != 1 || p[0].FastestTime != 139.4823 || p[0].ReasonOutStr != "Running" {
+		t.Errorf("unexpected results positions %+v", p)
+	}
+	if len(s.CameraInfo.Groups) != 2 || !s.CameraInfo.Groups[1].IsScenic {
+		t.Errorf("unexpected camera groups %+v", s.CameraInfo.Groups)
+	}
+	if f := s.RadioInfo.Radios[0].Frequencies[0]; f.FrequencyName != "@ALLTEAMS" || f.CarIdx != -1 {
+		t.Errorf("unexpected frequency %+v", f)
+	}
+	d := s.Driver(0)
+	if d == nil || d.UserName != "Max Racer" || d.CarNumber != "25" || d.CarClassColor != "0xffffff" || d.IRating != 1350 {
+		t.Errorf("unexpected driver %+v", d)
+	}
+	if s.Driver(5) != nil {
+		t.Error("expected no driver in car 5")
+	}
+	if len(s.SplitTimeInfo.Sectors) != 2 || s.SplitTimeInfo.Sectors[1].SectorStartPct != 0.32914 {
+		t.Errorf("unexpected sectors %+v", s.SplitTimeInfo.Sectors)
+	}
+	if _, ok := s.CarSetup["Tires"].(map[string]interface{}); !ok {
+		t.Errorf("unexpected car setup %+v", s.CarSetup)
+	}
+}
+
+func TestParseSessionInfoQuirks(t *testing.T) {
+	tests := []struct {
+		fixture string
+		drivers [][2]string // user and team name by car idx
+	}{
+		{"latin1_names.yaml", [][2]string{{"José Müller", "Équipe Française"}, {"Søren Åberg", "Team Øresund"}}},
+		{"colon_team.yaml", [][2]string{{"Alex Smith", "Racing: The Team"}, {"Sam Jones", "Team:Fast"}}},
+		{"leading_indicators.yaml", [][2]string{{"*Asterisk", "&Co Racing"}, {"!Bang", "@home racing"}, {"[Bracket]", "{Brace} Motorsport"}}},
+		{"comments_and_quotes.yaml", [][2]string{{`Chris "Flash" Gordon`, "Team #1"}, {`"Quoted`, `Back\slash Racing`}, {"Pat O'Neil", "Racing:"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			b, err := ioutil.ReadFile("testdata/session_quirks/" + tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+			s, err := ParseSessionInfo(decodeSessionString(b))
+			if err != nil {
+				t.Fatalf("ParseSessionInfo: %v", err)
+			}
+			if s.WeekendInfo.TrackCity != "Nürburg" {
+				t.Errorf("track city %q", s.WeekendInfo.TrackCity)
+			}
+			if len(s.DriverInfo.Drivers) != len(tt.drivers) {
+				t.Fatalf("got %d drivers want %d", len(s.DriverInfo.Drivers), len(tt.drivers))
+			}
+			for idx, want := range tt.drivers {
+				d := s.Driver(idx)
+				if d == nil || d.UserName != want[0] || d.TeamName != want[1] {
+					t.Errorf("car %d got %+v want %v", idx, d, want)
+				}
+				if d != nil && d.CarNumber != fmt.Sprint(idx+1) {
+					t.Errorf("car %d number %q", idx, d.CarNumber)
+				}
+			}
+		})
+	}
+}
+
+func TestSessionStringEncoding(t *testing.T) {
+	latin1 := []byte{'M', 0xfc, 'l', 'l', 'e', 'r', 0, 'x'}
+	s := decodeSessionString(latin1)
+	if s != "Müller" {
+		t.Errorf("decoded %q", s)
+	}
+	if b := encodeSessionString(s + "€"); string(b) != string(latin1[:6])+"?" {
+		t.Errorf("encoded %v", b)
+	}
+}
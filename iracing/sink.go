@@ -0,0 +1,425 @@
+package iracing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"go.uber.org/zap"
+)
+
+// sinkFlushInterval is how often sinks are flushed and sinks that failed to open are reopened
+const sinkFlushInterval = time.Second
+
+// sinkBuffer is the number of session info updates and events buffered for each sink
+const sinkBuffer = 64
+
+// sinkErrorBuffer is the number of sink errors buffered for SinkErrors before new ones are dropped
+const sinkErrorBuffer = 16
+
+// Sink writes telemetry frames somewhere, e.g. stdout, a file or a message broker.
+// Sinks are written from a single goroutine, only Health may be called concurrently.
+type Sink interface {
+	// Open connects the sink, it is retried while it fails
+	Open(ctx context.Context) error
+	// WriteFrame writes a frame of the variables selected for the sink
+	WriteFrame(f *Frame) error
+	// Flush writes out anything buffered
+	Flush() error
+	// Close flushes and releases the sink
+	Close() error
+	// Health returns nil while the sink is able to write or the reason it is not
+	Health() error
+}
+
+// SessionSink is implemented by sinks that also write session info and session events
+type SessionSink interface {
+	// WriteSessionInfo writes the session info yaml each time the sim updates it
+	WriteSessionInfo(sessionInfoYaml string) error
+	// WriteSessionEvent writes a change found in the session info
+	WriteSessionEvent(e *SessionEvent) error
+}
+
+// SinkConfig configures a sink
+type SinkConfig struct {
+	Type    string                 // registered sink type e.g. stdout, file or nats
+	Name    string                 // name of the sink in status and errors, defaults to Type
+	Vars    []string               // variables written in frames, all variables if empty
+	Rate    float64                // maximum frames per second, every tick if 0
+	Units   string                 // display units metric or imperial, irsdk units if empty
+	Options map[string]interface{} // options of the sink type
+}
+
+// SinkFactory creates a sink from its configuration
+type SinkFactory func(cfg *SinkConfig) (Sink, error)
+
+var sinkRegistry = struct {
+	sync.Mutex
+	factories map[string]SinkFactory
+}{factories: map[string]SinkFactory{}}
+
+// RegisterSink makes a sink type available to NewSink and ClientConfig.Sinks.
+// Registering a type twice replaces the first factory.
+func RegisterSink(sinkType string, factory SinkFactory) {
+	sinkRegistry.Lock()
+	defer sinkRegistry.Unlock()
+	sinkRegistry.factories[sinkType] = factory
+}
+
+// SinkTypes returns the registered sink types
+func SinkTypes() []string {
+	sinkRegistry.Lock()
+	defer sinkRegistry.Unlock()
+	types := make([]string, 0, len(sinkRegistry.factories))
+	for t := range sinkRegistry.factories {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// NewSink creates a sink of the registered type cfg.Type
+func NewSink(cfg *SinkConfig) (Sink, error) {
+	sinkRegistry.Lock()
+	factory, ok := sinkRegistry.factories[cfg.Type]
+	sinkRegistry.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown sink type %s, registered types %v", cfg.Type, SinkTypes())
+	}
+	return factory(cfg)
+}
+
+// decodeSinkOptions decodes the options of a sink config into the struct pointed to by v.
+// Durations may be given as strings such as 2s.
+func decodeSinkOptions(cfg *SinkConfig, v interface{}) error {
+	d, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+		WeaklyTypedInput: true,
+		Result:           v,
+	})
+	if err != nil {
+		return err
+	}
+	if err := d.Decode(cfg.Options); err != nil {
+		return fmt.Errorf("%s sink options: %w", cfg.Type, err)
+	}
+	return nil
+}
+
+// SinkMetrics counts what a sink has written
+type SinkMetrics struct {
+	Frames        uint64 // frames written
+	SessionWrites uint64 // session info updates and events written
+	Dropped       uint64 // frames and session writes dropped because the sink could not keep up
+	Errors        uint64 // failed opens, writes and flushes
+	LastWrite     time.Time
+}
+
+// SinkStatus is the health and metrics of a sink
+type SinkStatus struct {
+	Name    string
+	Type    string
+	Health  error // nil while healthy
+	Metrics SinkMetrics
+}
+
+// SinkError is sent on the client sink errors channel when a sink fails
+type SinkError struct {
+	Sink string
+	Err  error
+	Time time.Time
+}
+
+// MarshalJSON encodes the health as its error message, empty while healthy
+func (s SinkStatus) MarshalJSON() ([]byte, error) {
+	type status SinkStatus
+	var health string
+	if s.Health != nil {
+		health = s.Health.Error()
+	}
+	return json.Marshal(struct {
+		status
+		Health string
+	}{status(s), health})
+}
+
+func (e SinkError) Error() string {
+	return fmt.Sprintf("sink %s: %v", e.Sink, e.Err)
+}
+
+// Output feeds frames, session info and session events from the client reader to its sinks.
+// Each sink runs in its own goroutine fed by its own subscription so a slow sink does not
+// hold up the others.
+type Output struct {
+	runners []*sinkRunner
+	wg      sync.WaitGroup
+}
+
+// sinkMessage is a session info update or a session event queued for a sink
+type sinkMessage struct {
+	sessionInfo string
+	event       *SessionEvent
+}
+
+type sinkRunner struct {
+	name    string
+	cfg     SinkConfig
+	sink    Sink
+	units   DisplayUnits
+	sub     *Subscription
+	session chan sinkMessage
+	client  *Client
+
+	lock    sync.Mutex
+	opened  bool
+	openErr error
+	metrics SinkMetrics
+}
+
+// newOutput creates the sinks configured in cfgs and starts feeding them from client subscriptions
+func newOutput(ctx context.Context, client *Client, cfgs []SinkConfig) (*Output, error) {
+	o := &Output{}
+	for i := range cfgs {
+		cfg := cfgs[i]
+		if cfg.Name == "" {
+			cfg.Name = cfg.Type
+		}
+		units, err := ParseDisplayUnits(cfg.Units)
+		if err != nil {
+			return nil, fmt.Errorf("sink %s: %w", cfg.Name, err)
+		}
+		sink, err := NewSink(&cfg)
+		if err != nil {
+			return nil, fmt.Errorf("sink %s: %w", cfg.Name, err)
+		}
+		o.runners = append(o.runners, &sinkRunner{
+			name:    cfg.Name,
+			cfg:     cfg,
+			sink:    sink,
+			units:   units,
+			session: make(chan sinkMessage, sinkBuffer),
+			client:  client,
+		})
+	}
+	// subscribe once every sink is created so a config error leaves no subscriptions behind
+	for _, r := range o.runners {
+		r.sub = client.Subscribe(r.cfg.Vars, &SubscribeOptions{Rate: r.cfg.Rate, Buffer: sinkBuffer, Units: r.units})
+		o.wg.Add(1)
+		go func(r *sinkRunner) {
+			defer o.wg.Done()
+			r.run(ctx)
+		}(r)
+	}
+	return o, nil
+}
+
+// writeSessionInfo queues the session info yaml for every sink
+func (o *Output) writeSessionInfo(sessionInfoYaml string) {
+	for _, r := range o.runners {
+		r.queue(sinkMessage{sessionInfo: sessionInfoYaml})
+	}
+}
+
+// writeSessionEvent queues a session event for every sink
+func (o *Output) writeSessionEvent(e *SessionEvent) {
+	for _, r := range o.runners {
+		r.queue(sinkMessage{event: e})
+	}
+}
+
+// Status returns the health and metrics of each sink
+func (o *Output) Status() []SinkStatus {
+	status := make([]SinkStatus, len(o.runners))
+	for i, r := range o.runners {
+		status[i] = r.status()
+	}
+	return status
+}
+
+// Close ends delivery to the sinks, waits for them to write what they were sent and closes them
+func (o *Output) Close() error {
+	for _, r := range o.runners {
+		r.sub.Unsubscribe()
+	}
+	o.wg.Wait()
+	var err error
+	for _, r := range o.runners {
+		if cerr := r.sink.Close(); cerr != nil {
+			r.fail(cerr)
+			if err == nil {
+				err = fmt.Errorf("closing sink %s: %w", r.name, cerr)
+			}
+		}
+	}
+	return err
+}
+
+// run writes frames and session messages to the sink until its subscription ends
+func (r *sinkRunner) run(ctx context.Context) {
+	r.open(ctx)
+	ticker := time.NewTicker(sinkFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case f, ok := <-r.sub.C:
+			if !ok {
+				r.drain()
+				r.flush()
+				return
+			}
+			r.write(func() error { return r.sink.WriteFrame(f) }, &r.metrics.Frames)
+		case m := <-r.session:
+			r.writeSession(m)
+		case <-ticker.C:
+			if !r.isOpen() {
+				r.open(ctx)
+			}
+			r.flush()
+		}
+	}
+}
+
+// queue sends m to the sink without blocking the reader, dropping it if the sink is behind
+func (r *sinkRunner) queue(m sinkMessage) {
+	select {
+	case r.session <- m:
+	default:
+		r.lock.Lock()
+		r.metrics.Dropped++
+		r.lock.Unlock()
+	}
+}
+
+// drain writes the session messages queued before the subscription ended
+func (r *sinkRunner) drain() {
+	for {
+		select {
+		case m := <-r.session:
+			r.writeSession(m)
+		default:
+			return
+		}
+	}
+}
+
+func (r *sinkRunner) writeSession(m sinkMessage) {
+	ss, ok := r.sink.(SessionSink)
+	if !ok {
+		return
+	}
+	r.write(func() error {
+		if m.event != nil {
+			return ss.WriteSessionEvent(m.event)
+		}
+		return ss.WriteSessionInfo(m.sessionInfo)
+	}, &r.metrics.SessionWrites)
+}
+
+func (r *sinkRunner) open(ctx context.Context) {
+	err := r.sink.Open(ctx)
+	r.lock.Lock()
+	r.opened = err == nil
+	r.openErr = err
+	r.lock.Unlock()
+	if err != nil {
+		r.fail(fmt.Errorf("opening: %w", err))
+	}
+}
+
+func (r *sinkRunner) isOpen() bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.opened
+}
+
+// write calls w if the sink is open counting the write in count or the error
+func (r *sinkRunner) write(w func() error, count *uint64) {
+	if !r.isOpen() {
+		r.lock.Lock()
+		r.metrics.Dropped++
+		r.lock.Unlock()
+		return
+	}
+	if err := w(); err != nil {
+		r.fail(err)
+		return
+	}
+	r.lock.Lock()
+	*count++
+	r.metrics.LastWrite = time.Now()
+	r.lock.Unlock()
+}
+
+func (r *sinkRunner) flush() {
+	if !r.isOpen() {
+		return
+	}
+	if err := r.sink.Flush(); err != nil {
+		r.fail(fmt.Errorf("flushing: %w", err))
+	}
+}
+
+// fail counts and reports a sink error
+func (r *sinkRunner) fail(err error) {
+	r.lock.Lock()
+	r.metrics.Errors++
+	r.lock.Unlock()
+	r.client.reportSinkError(SinkError{Sink: r.name, Err: err, Time: time.Now()})
+}
+
+func (r *sinkRunner) status() SinkStatus {
+	r.lock.Lock()
+	s := SinkStatus{Name: r.name, Type: r.cfg.Type, Health: r.openErr, Metrics: r.metrics}
+	opened := r.opened
+	r.lock.Unlock()
+	s.Metrics.Dropped += r.sub.Dropped()
+	if opened {
+		s.Health = r.sink.Health()
+	}
+	return s
+}
+
+// SinkErrors returns a channel receiving the errors of the configured sinks.
+// Errors are dropped if the channel is not read and its buffer fills.
+func (ir *Client) SinkErrors() <-chan SinkError {
+	return ir.sinkErrors
+}
+
+// SinkStatus returns the health and metrics of the configured sinks while Run is running
+func (ir *Client) SinkStatus() []SinkStatus {
+	ir.outputLock.Lock()
+	defer ir.outputLock.Unlock()
+	if ir.output == nil {
+		return nil
+	}
+	return ir.output.Status()
+}
+
+// SetSinkVars changes the variables written to the sink named name while Run is running,
+// every variable if vars is empty
+func (ir *Client) SetSinkVars(name string, vars []string) error {
+	ir.outputLock.Lock()
+	defer ir.outputLock.Unlock()
+	if ir.output == nil {
+		return fmt.Errorf("sinks are not running")
+	}
+	for _, r := range ir.output.runners {
+		if r.name == name {
+			r.sub.SetVars(vars)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown sink %s", name)
+}
+
+func (ir *Client) reportSinkError(e SinkError) {
+	ir.logger.Warn("sink error", zap.String("sink", e.Sink), zap.Error(e.Err))
+	select {
+	case ir.sinkErrors <- e:
+	default:
+	}
+}
@@ -0,0 +1,301 @@
+package iracing
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+func init() {
+	RegisterSink("mqtt", newMQTTSink)
+}
+
+// mqttDisconnectQuiesce is how long closing waits for in flight work before disconnecting, in milliseconds
+const mqttDisconnectQuiesce = 250
+
+// MQTTSinkOptions are the options of the mqtt sink
+type MQTTSinkOptions struct {
+	Brokers  []string // broker urls e.g. tcp://127.0.0.1:1883, ssl://host:8883 or ws://host/mqtt
+	Broker   string   // a single broker url, added to Brokers
+	ClientID string   // client id, defaults to goiracing
+	Username string
+	Password string
+	TLS      MQTTTLSOptions
+
+	// Topic is the template of frame topics, defaults to iracing/frame. Templates may use <car> for the
+	// player car and <track> for the track. With <var> each variable is published to its own topic
+	// as a json value rather than all of them as a json frame.
+	Topic        string
+	SessionTopic string // topic template of the retained session info, defaults to iracing/session
+	EventTopic   string // topic template of session events, defaults to iracing/event
+	StatusTopic  string // topic of the retained online status, offline is set as the will, not used if empty
+	QoS          byte   // quality of service of every publish, 0, 1 or 2
+	Retain       bool   // retain frames so new subscribers get the latest values
+
+	CleanSession   bool          // start a clean session on each connect, defaults to true
+	KeepAlive      time.Duration // defaults to 30s
+	ConnectTimeout time.Duration // defaults to 5s
+	MaxReconnect   time.Duration // maximum delay between reconnect attempts, defaults to 10s
+	FlushTimeout   time.Duration // how long a flush waits for the broker to acknowledge publishes, defaults to 2s
+}
+
+// MQTTTLSOptions configures tls connections to the broker
+type MQTTTLSOptions struct {
+	CA                 string // path of the root certificate authorities
+	Cert               string // path of the client certificate
+	Key                string // path of the client certificate key
+	InsecureSkipVerify bool
+}
+
+// mqttSink publishes frames, session info and session events as json
+type mqttSink struct {
+	opts MQTTSinkOptions
+
+	lock         sync.Mutex // guards client for Health and the session info for the reconnect handler
+	client       mqtt.Client
+	sessionInfo  string // latest session info, republished on reconnect
+	sessionTopic string // topic of the latest session info
+
+	topics  subjectTemplate
+	pending []mqtt.Token
+}
+
+func newMQTTSink(cfg *SinkConfig) (Sink, error) {
+	opts := MQTTSinkOptions{
+		ClientID:       "goiracing",
+		Topic:          "iracing/frame",
+		SessionTopic:   "iracing/session",
+		EventTopic:     "iracing/event",
+		CleanSession:   true,
+		KeepAlive:      30 * time.Second,
+		ConnectTimeout: 5 * time.Second,
+		MaxReconnect:   10 * time.Second,
+		FlushTimeout:   2 * time.Second,
+	}
+	if err := decodeSinkOptions(cfg, &opts); err != nil {
+		return nil, err
+	}
+	if opts.QoS > 2 {
+		return nil, fmt.Errorf("mqtt sink qos %d, must be 0, 1 or 2", opts.QoS)
+	}
+	if opts.Broker != "" {
+		opts.Brokers = append(opts.Brokers, opts.Broker)
+	}
+	if len(opts.Brokers) == 0 {
+		opts.Brokers = []string{"tcp://127.0.0.1:1883"}
+	}
+	return &mqttSink{opts: opts, topics: newSubjectTemplate(topicToken)}, nil
+}
+
+// clientOptions returns the paho client options of the sink options
+func (s *mqttSink) clientOptions() (*mqtt.ClientOptions, error) {
+	o := mqtt.NewClientOptions().
+		SetClientID(s.opts.ClientID).
+		SetUsername(s.opts.Username).
+		SetPassword(s.opts.Password).
+		SetCleanSession(s.opts.CleanSession).
+		SetKeepAlive(s.opts.KeepAlive).
+		SetConnectTimeout(s.opts.ConnectTimeout).
+		SetMaxReconnectInterval(s.opts.MaxReconnect).
+		SetAutoReconnect(true).
+		SetOrderMatters(false).
+		SetOnConnectHandler(s.onConnect)
+	for _, b := range s.opts.Brokers {
+		o.AddBroker(b)
+	}
+	if s.opts.StatusTopic != "" {
+		o.SetWill(s.opts.StatusTopic, "offline", s.opts.QoS, true)
+	}
+	tlsConfig, err := s.opts.TLS.config()
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		o.SetTLSConfig(tlsConfig)
+	}
+	return o, nil
+}
+
+// config returns the tls config of the options, nil if no tls options are set
+func (o *MQTTTLSOptions) config() (*tls.Config, error) {
+	if o.CA == "" && o.Cert == "" && o.Key == "" && !o.InsecureSkipVerify {
+		return nil, nil
+	}
+	c := &tls.Config{InsecureSkipVerify: o.InsecureSkipVerify}
+	if o.CA != "" {
+		pem, err := ioutil.ReadFile(o.CA)
+		if err != nil {
+			return nil, fmt.Errorf("reading mqtt ca: %w", err)
+		}
+		c.RootCAs = x509.NewCertPool()
+		if !c.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates in mqtt ca %s", o.CA)
+		}
+	}
+	if o.Cert != "" || o.Key != "" {
+		cert, err := tls.LoadX509KeyPair(o.Cert, o.Key)
+		if err != nil {
+			return nil, fmt.Errorf("reading mqtt client certificate: %w", err)
+		}
+		c.Certificates = []tls.Certificate{cert}
+	}
+	return c, nil
+}
+
+func (s *mqttSink) Open(ctx context.Context) error {
+	opts, err := s.clientOptions()
+	if err != nil {
+		return err
+	}
+	client := mqtt.NewClient(opts)
+	token := client.Connect()
+	if !token.WaitTimeout(s.opts.ConnectTimeout) {
+		client.Disconnect(0)
+		return fmt.Errorf("timed out connecting to mqtt broker")
+	}
+	if err := token.Error(); err != nil {
+		return err
+	}
+	s.lock.Lock()
+	s.client = client
+	s.lock.Unlock()
+	return nil
+}
+
+// onConnect sets the online status and republishes the retained session info each time the client
+// connects or reconnects, in case the broker lost its retained messages
+func (s *mqttSink) onConnect(client mqtt.Client) {
+	if s.opts.StatusTopic != "" {
+		client.Publish(s.opts.StatusTopic, s.opts.QoS, true, "online")
+	}
+	s.lock.Lock()
+	info, topic := s.sessionInfo, s.sessionTopic
+	s.lock.Unlock()
+	if info != "" {
+		client.Publish(topic, s.opts.QoS, true, info)
+	}
+}
+
+func (s *mqttSink) WriteFrame(f *Frame) error {
+	topic := s.topics.expand(s.opts.Topic, "")
+	if !strings.Contains(topic, "<var>") {
+		return s.publish(topic, s.opts.Retain, f)
+	}
+	for name, v := range f.Values {
+		if err := s.publish(strings.ReplaceAll(topic, "<var>", topicToken(name)), s.opts.Retain, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteSessionInfo publishes the session info as a retained message. The car and track of topic
+// templates are taken from the session info. The topics are only used by the writer, the reconnect
+// handler republishes to the topic kept with the session info.
+func (s *mqttSink) WriteSessionInfo(sessionInfoYaml string) error {
+	if session, err := ParseSessionInfo(sessionInfoYaml); session != nil {
+		s.topics.setSession(session)
+	} else if err != nil {
+		return err
+	}
+	topic := s.topics.expand(s.opts.SessionTopic, "")
+	s.lock.Lock()
+	s.sessionInfo, s.sessionTopic = sessionInfoYaml, topic
+	s.lock.Unlock()
+	s.pending = append(s.pending, s.client.Publish(topic, s.opts.QoS, true, sessionInfoYaml))
+	return nil
+}
+
+func (s *mqttSink) WriteSessionEvent(e *SessionEvent) error {
+	return s.publish(s.topics.expand(s.opts.EventTopic, ""), false, e)
+}
+
+// publish publishes v as json keeping the token to check on Flush
+func (s *mqttSink) publish(topic string, retain bool, v interface{}) error {
+	msg, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	token := s.client.Publish(topic, s.opts.QoS, retain, msg)
+	if s.opts.QoS == 0 {
+		// nothing is acknowledged at qos 0, only fail on errors already known
+		select {
+		case <-token.Done():
+			return token.Error()
+		default:
+			return nil
+		}
+	}
+	s.pending = append(s.pending, token)
+	return nil
+}
+
+// Flush waits for the broker to acknowledge what was published
+func (s *mqttSink) Flush() error {
+	if len(s.pending) == 0 {
+		return nil
+	}
+	pending := s.pending
+	s.pending = nil
+	deadline := time.Now().Add(s.opts.FlushTimeout)
+	failed := 0
+	var firstErr error
+	for _, token := range pending {
+		if !token.WaitTimeout(time.Until(deadline)) {
+			return fmt.Errorf("timed out waiting for %d mqtt publishes", len(pending))
+		}
+		if err := token.Error(); err != nil {
+			failed++
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d mqtt publishes failed: %w", failed, len(pending), firstErr)
+	}
+	return nil
+}
+
+// Close flushes what was published, sets the offline status and disconnects
+func (s *mqttSink) Close() error {
+	if s.client == nil {
+		return nil
+	}
+	err := s.Flush()
+	if s.opts.StatusTopic != "" && s.client.IsConnectionOpen() {
+		s.client.Publish(s.opts.StatusTopic, s.opts.QoS, true, "offline").WaitTimeout(s.opts.FlushTimeout)
+	}
+	s.client.Disconnect(mqttDisconnectQuiesce)
+	return err
+}
+
+func (s *mqttSink) Health() error {
+	s.lock.Lock()
+	client := s.client
+	s.lock.Unlock()
+	if client == nil || !client.IsConnectionOpen() {
+		return errors.New("not connected to mqtt broker")
+	}
+	return nil
+}
+
+// topicToken replaces the characters not allowed in a topic level
+func topicToken(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '+', '#', ' ', '\t':
+			return '_'
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,283 @@
+package iracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"net"
+	"sync"
+	"testing"
+	"time"
+)
+
+// mqttTestBroker is a minimal mqtt 3.1.1 broker that keeps what is published to it, enough for sinks
+// to connect, publish at any qos and reconnect
+type mqttTestBroker struct {
+	ln net.Listener
+
+	lock     sync.Mutex
+	conns    map[net.Conn]bool
+	messages []mqttTestMessage
+	retained map[string]string
+}
+
+type mqttTestMessage struct {
+	topic   string
+	payload string
+	retain  bool
+}
+
+// runMQTTBroker starts a test broker on a local port
+func runMQTTBroker(t *testing.T) *mqttTestBroker {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := &mqttTestBroker{ln: ln, conns: map[net.Conn]bool{}, retained: map[string]string{}}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			b.lock.Lock()
+			b.conns[conn] = true
+			b.lock.Unlock()
+			go b.serve(conn)
+		}
+	}()
+	t.Cleanup(func() {
+		ln.Close()
+		b.restart()
+	})
+	return b
+}
+
+func (b *mqttTestBroker) url() string {
+	return "tcp://" + b.ln.Addr().String()
+}
+
+// restart drops every connection and the retained messages as a broker restart without persistence would
+func (b *mqttTestBroker) restart() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for conn := range b.conns {
+		conn.Close()
+	}
+	b.conns = map[net.Conn]bool{}
+	b.retained = map[string]string{}
+}
+
+func (b *mqttTestBroker) retainedMessage(topic string) (string, bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	m, ok := b.retained[topic]
+	return m, ok
+}
+
+func (b *mqttTestBroker) published(topic string) []string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	var payloads []string
+	for _, m := range b.messages {
+		if m.topic == topic {
+			payloads = append(payloads, m.payload)
+		}
+	}
+	return payloads
+}
+
+func (b *mqttTestBroker) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		header, err := r.ReadByte()
+		if err != nil {
+			return
+		}
+		length, mul := 0, 1
+		for {
+			c, err := r.ReadByte()
+			if err != nil {
+				return
+			}
+			length += int(c&0x7f) * mul
+			mul *= 128
+			if c&0x80 == 0 {
+				break
+			}
+		}
+		body := make([]byte, length)
+		if _, err := io.ReadFull(r, body); err != nil {
+			return
+		}
+
+		var reply []byte
+		switch header >> 4 {
+		case 1: // connect
+			reply = []byte{0x20, 2, 0, 0}
+		case 3: // publish
+			qos := header >> 1 & 3
+			n := int(binary.BigEndian.Uint16(body))
+			m := mqttTestMessage{topic: string(body[2 : 2+n]), retain: header&1 != 0}
+			rest := body[2+n:]
+			if qos > 0 {
+				id := rest[:2]
+				rest = rest[2:]
+				reply = []byte{0x40, 2, id[0], id[1]} // puback
+				if qos == 2 {
+					reply[0] = 0x50 // pubrec
+				}
+			}
+			m.payload = string(rest)
+			b.lock.Lock()
+			b.messages = append(b.messages, m)
+			if m.retain {
+				b.retained[m.topic] = m.payload
+			}
+			b.lock.Unlock()
+		case 6: // pubrel
+			reply = []byte{0x70, 2, body[0], body[1]}
+		case 12: // pingreq
+			reply = []byte{0xd0, 0}
+		case 14: // disconnect
+			return
+		}
+		if reply != nil {
+			if _, err := conn.Write(reply); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func TestMQTTSink(t *testing.T) {
+	broker := runMQTTBroker(t)
+	sink, err := NewSink(&SinkConfig{Type: "mqtt", Options: map[string]interface{}{
+		"broker":       broker.url(),
+		"topic":        "iracing/<car>/<var>",
+		"statustopic":  "iracing/status",
+		"qos":          1,
+		"retain":       true,
+		"maxreconnect": "50ms",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile("testdata/session_basic.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Open(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.(SessionSink).WriteSessionInfo(string(b)); err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.WriteFrame(&Frame{TickCount: 1, Values: map[string]interface{}{"Speed": float32(10), "Lap": int32(2)}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.(SessionSink).WriteSessionEvent(&SessionEvent{Type: DriverJoined, CarIdx: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Health(); err != nil {
+		t.Errorf("unexpected health %v", err)
+	}
+
+	if got, ok := broker.retainedMessage("iracing/bmwm4gt3/Speed"); !ok || got != "10" {
+		t.Errorf("retained speed %q %v", got, ok)
+	}
+	if got := broker.published("iracing/bmwm4gt3/Lap"); len(got) != 1 || got[0] != "2" {
+		t.Errorf("lap got %v", got)
+	}
+	if got := broker.published("iracing/event"); len(got) != 1 {
+		t.Errorf("events got %v", got)
+	}
+	if got, _ := broker.retainedMessage("iracing/session"); got != string(b) {
+		t.Error("session info not retained")
+	}
+	if got, _ := broker.retainedMessage("iracing/status"); got != "online" {
+		t.Errorf("status %q want online", got)
+	}
+
+	// the client reconnects after the broker restarts and puts back the retained session info
+	broker.restart()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if got, _ := broker.retainedMessage("iracing/session"); got == string(b) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("session info not republished after reconnect")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := broker.retainedMessage("iracing/status"); got != "offline" {
+		t.Errorf("status %q want offline", got)
+	}
+}
+
+// TestMQTTSinkReconnectDuringSessionInfo reconnects while session info is written, for the race detector
+func TestMQTTSinkReconnectDuringSessionInfo(t *testing.T) {
+	broker := runMQTTBroker(t)
+	sink, err := NewSink(&SinkConfig{Type: "mqtt", Options: map[string]interface{}{
+		"broker":       broker.url(),
+		"sessiontopic": "iracing/<car>/session",
+		"maxreconnect": "10ms",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile("testdata/session_basic.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Open(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 5; i++ {
+			broker.restart()
+			time.Sleep(20 * time.Millisecond)
+		}
+	}()
+	for {
+		select {
+		case <-done:
+			deadline := time.Now().Add(5 * time.Second)
+			for {
+				if got, _ := broker.retainedMessage("iracing/bmwm4gt3/session"); got == string(b) {
+					break
+				}
+				if time.Now().After(deadline) {
+					t.Fatal("session info not republished after reconnect")
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+			// paho races Disconnect against the workers of a reconnect, close once the connection is lost
+			broker.ln.Close()
+			broker.restart()
+			for sink.Health() == nil {
+				time.Sleep(time.Millisecond)
+			}
+			sink.Close()
+			return
+		default:
+			sink.(SessionSink).WriteSessionInfo(string(b))
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
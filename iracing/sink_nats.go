@@ -0,0 +1,396 @@
+package iracing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+func init() {
+	RegisterSink("nats", newNATSSink)
+}
+
+// natsFlushTimeout is how long a flush waits for the server to acknowledge what was published
+const natsFlushTimeout = 2 * time.Second
+
+// NATSConnOptions configures a connection to nats servers
+type NATSConnOptions struct {
+	URLs []string // server urls, defaults to nats://127.0.0.1:4222
+	URL  string   // a single server url, added to URLs
+	Name string   // connection name shown by the server
+
+	Creds string // path of a user credentials file
+	NKey  string // path of an nkey seed file
+	TLS   NATSTLSOptions
+
+	Reconnect NATSReconnectOptions
+}
+
+// NATSSinkOptions are the options of the nats sink
+type NATSSinkOptions struct {
+	NATSConnOptions `mapstructure:",squash"`
+
+	// Subject is the template of frame subjects, defaults to iracing.frame. Templates may
+	// use <car> for the player car, <track> for the track and <group> for the variable group.
+	Subject        string
+	SessionSubject string              // subject template of session info, defaults to iracing.session
+	EventSubject   string              // subject template of session events, defaults to iracing.session.event
+	Groups         map[string][]string // variable groups published as separate frames by group name, one frame group if empty
+
+	JetStream NATSJetStreamOptions
+
+	SessionBucket string // key value bucket the session info is put in, created if missing, not used if empty
+	SessionKey    string // key template of the session info in SessionBucket, defaults to session
+}
+
+// NATSTLSOptions configures tls connections to the server
+type NATSTLSOptions struct {
+	CA   string // path of the root certificate authorities
+	Cert string // path of the client certificate
+	Key  string // path of the client certificate key
+}
+
+// NATSReconnectOptions configures reconnection when the connection to the server is lost
+type NATSReconnectOptions struct {
+	MaxReconnects        int           // attempts before giving up, defaults to 60, -1 retries forever
+	Wait                 time.Duration // delay between attempts to the same server, defaults to 2s
+	Jitter               time.Duration // random delay added to Wait, defaults to 100ms
+	RetryOnFailedConnect bool          // keep trying to connect in the background if the first connect fails
+	BufferSize           int           // bytes buffered while reconnecting, defaults to 8MB
+}
+
+// NATSJetStreamOptions configures publishing frames to JetStream
+type NATSJetStreamOptions struct {
+	Enabled    bool          // publish frames to JetStream and wait for acks
+	Stream     string        // stream created for the sink subjects if missing, the stream must exist if empty
+	MaxPending int           // publishes in flight before publishing blocks, defaults to 4000
+	AckTimeout time.Duration // how long a flush waits for acks, defaults to 2s
+}
+
+// natsSink publishes frames, session info and session events as json
+type natsSink struct {
+	opts NATSSinkOptions
+
+	lock sync.Mutex // guards nc for Health
+	nc   *nats.Conn
+	js   nats.JetStreamContext
+	kv   nats.KeyValue
+
+	subjects subjectTemplate
+	pending  []nats.PubAckFuture
+}
+
+func newNATSSink(cfg *SinkConfig) (Sink, error) {
+	opts := NATSSinkOptions{
+		NATSConnOptions: DefaultNATSConnOptions,
+		Subject:         "iracing.frame",
+		SessionSubject:  "iracing.session",
+		EventSubject:    "iracing.session.event",
+		SessionKey:      "session",
+	}
+	if err := decodeSinkOptions(cfg, &opts); err != nil {
+		return nil, err
+	}
+	if opts.JetStream.AckTimeout <= 0 {
+		opts.JetStream.AckTimeout = natsFlushTimeout
+	}
+	return &natsSink{opts: opts, subjects: newSubjectTemplate(subjectToken)}, nil
+}
+
+// DefaultNATSConnOptions are the connection options used for the options that are not set
+var DefaultNATSConnOptions = NATSConnOptions{
+	Reconnect: NATSReconnectOptions{
+		MaxReconnects: nats.DefaultMaxReconnect,
+		Wait:          nats.DefaultReconnectWait,
+		Jitter:        nats.DefaultReconnectJitter,
+	},
+}
+
+// connect connects to the nats servers
+func (o *NATSConnOptions) connect() (*nats.Conn, error) {
+	opts, err := o.connectOptions()
+	if err != nil {
+		return nil, err
+	}
+	urls := o.URLs
+	if o.URL != "" {
+		urls = append(urls[:len(urls):len(urls)], o.URL)
+	}
+	if len(urls) == 0 {
+		urls = []string{nats.DefaultURL}
+	}
+	return nats.Connect(strings.Join(urls, ","), opts...)
+}
+
+// connectOptions returns the nats connect options of the connection options
+func (o *NATSConnOptions) connectOptions() ([]nats.Option, error) {
+	opts := []nats.Option{
+		nats.MaxReconnects(o.Reconnect.MaxReconnects),
+		nats.ReconnectWait(o.Reconnect.Wait),
+		nats.ReconnectJitter(o.Reconnect.Jitter, o.Reconnect.Jitter),
+		nats.RetryOnFailedConnect(o.Reconnect.RetryOnFailedConnect),
+	}
+	if o.Name != "" {
+		opts = append(opts, nats.Name(o.Name))
+	}
+	if o.Reconnect.BufferSize > 0 {
+		opts = append(opts, nats.ReconnectBufSize(o.Reconnect.BufferSize))
+	}
+	if o.Creds != "" {
+		opts = append(opts, nats.UserCredentials(o.Creds))
+	}
+	if o.NKey != "" {
+		opt, err := nats.NkeyOptionFromSeed(o.NKey)
+		if err != nil {
+			return nil, fmt.Errorf("reading nkey seed: %w", err)
+		}
+		opts = append(opts, opt)
+	}
+	if o.TLS.CA != "" {
+		opts = append(opts, nats.RootCAs(o.TLS.CA))
+	}
+	if o.TLS.Cert != "" || o.TLS.Key != "" {
+		opts = append(opts, nats.ClientCert(o.TLS.Cert, o.TLS.Key))
+	}
+	return opts, nil
+}
+
+func (s *natsSink) Open(ctx context.Context) error {
+	nc, err := s.opts.connect()
+	if err != nil {
+		return err
+	}
+	if err := s.openJetStream(nc); err != nil {
+		nc.Close()
+		return err
+	}
+	s.lock.Lock()
+	s.nc = nc
+	s.lock.Unlock()
+	return nil
+}
+
+// openJetStream creates the stream and key value bucket the sink uses
+func (s *natsSink) openJetStream(nc *nats.Conn) error {
+	if !s.opts.JetStream.Enabled && s.opts.SessionBucket == "" {
+		return nil
+	}
+	var jsOpts []nats.JSOpt
+	if s.opts.JetStream.MaxPending > 0 {
+		jsOpts = append(jsOpts, nats.PublishAsyncMaxPending(s.opts.JetStream.MaxPending))
+	}
+	js, err := nc.JetStream(jsOpts...)
+	if err != nil {
+		return err
+	}
+	s.js = js
+
+	if s.opts.JetStream.Enabled && s.opts.JetStream.Stream != "" {
+		_, err := js.AddStream(&nats.StreamConfig{
+			Name: s.opts.JetStream.Stream,
+			Subjects: []string{
+				wildcardSubject(s.opts.Subject),
+				wildcardSubject(s.opts.SessionSubject),
+				wildcardSubject(s.opts.EventSubject),
+			},
+		})
+		if err != nil && !errors.Is(err, nats.ErrStreamNameAlreadyInUse) {
+			return fmt.Errorf("creating stream %s: %w", s.opts.JetStream.Stream, err)
+		}
+	}
+
+	if s.opts.SessionBucket != "" {
+		kv, err := js.KeyValue(s.opts.SessionBucket)
+		if errors.Is(err, nats.ErrBucketNotFound) {
+			kv, err = js.CreateKeyValue(&nats.KeyValueConfig{Bucket: s.opts.SessionBucket})
+		}
+		if err != nil {
+			return fmt.Errorf("opening key value bucket %s: %w", s.opts.SessionBucket, err)
+		}
+		s.kv = kv
+	}
+	return nil
+}
+
+func (s *natsSink) WriteFrame(f *Frame) error {
+	if len(s.opts.Groups) == 0 {
+		return s.publish(s.subjects.expand(s.opts.Subject, "frame"), f)
+	}
+	for group, vars := range s.opts.Groups {
+		gf := &Frame{
+			TickCount:   f.TickCount,
+			SessionTime: f.SessionTime,
+			Values:      make(map[string]interface{}, len(vars)),
+			Units:       make(map[string]Unit, len(vars)),
+		}
+		for _, name := range vars {
+			if v, ok := f.Values[name]; ok {
+				gf.Values[name] = v
+			}
+			if u, ok := f.Units[name]; ok {
+				gf.Units[name] = u
+			}
+		}
+		if err := s.publish(s.subjects.expand(s.opts.Subject, group), gf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteSessionInfo publishes the session info and puts it in the session bucket. The car and track
+// of subject templates are taken from the session info.
+func (s *natsSink) WriteSessionInfo(sessionInfoYaml string) error {
+	if session, err := ParseSessionInfo(sessionInfoYaml); session != nil {
+		s.subjects.setSession(session)
+	} else if err != nil {
+		return err
+	}
+	if s.kv != nil {
+		if _, err := s.kv.PutString(s.subjects.expand(s.opts.SessionKey, "session"), sessionInfoYaml); err != nil {
+			return fmt.Errorf("putting session info in %s: %w", s.opts.SessionBucket, err)
+		}
+	}
+	return s.publishMsg(s.subjects.expand(s.opts.SessionSubject, "session"), []byte(sessionInfoYaml))
+}
+
+func (s *natsSink) WriteSessionEvent(e *SessionEvent) error {
+	return s.publish(s.subjects.expand(s.opts.EventSubject, "event"), e)
+}
+
+// publish publishes v as json
+func (s *natsSink) publish(subject string, v interface{}) error {
+	msg, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return s.publishMsg(subject, msg)
+}
+
+// publishMsg publishes msg, to JetStream if enabled keeping the ack to check on Flush
+func (s *natsSink) publishMsg(subject string, msg []byte) error {
+	if !s.opts.JetStream.Enabled {
+		return s.nc.Publish(subject, msg)
+	}
+	ack, err := s.js.PublishAsync(subject, msg)
+	if err != nil {
+		return err
+	}
+	s.pending = append(s.pending, ack)
+	return nil
+}
+
+// Flush waits for the server to receive what was published and, with JetStream, for the acks
+func (s *natsSink) Flush() error {
+	if err := s.nc.FlushTimeout(natsFlushTimeout); err != nil {
+		return err
+	}
+	if len(s.pending) == 0 {
+		return nil
+	}
+	pending := s.pending
+	s.pending = nil
+	timeout := time.After(s.opts.JetStream.AckTimeout)
+	failed := 0
+	var firstErr error
+	for _, ack := range pending {
+		select {
+		case <-ack.Ok():
+		case err := <-ack.Err():
+			failed++
+			if firstErr == nil {
+				firstErr = err
+			}
+		case <-timeout:
+			return fmt.Errorf("timed out waiting for %d JetStream acks", len(pending))
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d JetStream publishes failed: %w", failed, len(pending), firstErr)
+	}
+	return nil
+}
+
+// Close flushes what was published and closes the connection
+func (s *natsSink) Close() error {
+	if s.nc == nil {
+		return nil
+	}
+	err := s.Flush()
+	s.nc.Close()
+	return err
+}
+
+func (s *natsSink) Health() error {
+	s.lock.Lock()
+	nc := s.nc
+	s.lock.Unlock()
+	if nc == nil || !nc.IsConnected() {
+		return errors.New("not connected to nats")
+	}
+	return nil
+}
+
+// subjectTemplate expands the placeholders of subject and topic templates
+type subjectTemplate struct {
+	values map[string]string
+	token  func(string) string // replaces the characters a value may not contain
+}
+
+func newSubjectTemplate(token func(string) string) subjectTemplate {
+	return subjectTemplate{values: map[string]string{}, token: token}
+}
+
+// subjectPlaceholders are the placeholders subject templates may use
+var subjectPlaceholders = []string{"<car>", "<track>", "<group>"}
+
+// setSession takes the car and track placeholder values from the session info
+func (t *subjectTemplate) setSession(s *Session) {
+	if d := s.Driver(s.DriverInfo.DriverCarIdx); d != nil {
+		t.values["<car>"] = t.token(d.CarPath)
+	}
+	t.values["<track>"] = t.token(s.WeekendInfo.TrackName)
+}
+
+// expand returns the subject of the template for group. Placeholders without a value are unknown.
+func (t *subjectTemplate) expand(template, group string) string {
+	if !strings.Contains(template, "<") {
+		return template
+	}
+	for _, p := range subjectPlaceholders {
+		v := t.values[p]
+		if p == "<group>" {
+			v = t.token(group)
+		}
+		if v == "" {
+			v = "unknown"
+		}
+		template = strings.ReplaceAll(template, p, v)
+	}
+	return template
+}
+
+// wildcardSubject returns the subject matching every expansion of template
+func wildcardSubject(template string) string {
+	for _, p := range subjectPlaceholders {
+		template = strings.ReplaceAll(template, p, "*")
+	}
+	return template
+}
+
+// subjectToken replaces the characters not allowed in a subject token
+func subjectToken(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '.', '*', '>', ' ', '\t':
+			return '_'
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,145 @@
+package iracing
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+)
+
+// runNATSServer starts an embedded nats server with JetStream enabled
+func runNATSServer(t *testing.T) *server.Server {
+	t.Helper()
+	s, err := server.NewServer(&server.Options{Host: "127.0.0.1", Port: -1, JetStream: true, StoreDir: t.TempDir(), NoLog: true, NoSigs: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Start()
+	if !s.ReadyForConnections(5 * time.Second) {
+		t.Fatal("nats server not ready")
+	}
+	t.Cleanup(s.Shutdown)
+	return s
+}
+
+func TestNATSSink(t *testing.T) {
+	srv := runNATSServer(t)
+	sink, err := NewSink(&SinkConfig{Type: "nats", Options: map[string]interface{}{
+		"url":           srv.ClientURL(),
+		"subject":       "iracing.<car>.<group>",
+		"groups":        map[string]interface{}{"speed": []string{"Speed"}, "lap": []string{"Lap"}},
+		"jetstream":     map[string]interface{}{"enabled": true, "stream": "IRACING", "acktimeout": "1s"},
+		"sessionbucket": "iracing",
+		"reconnect":     map[string]interface{}{"wait": "10ms"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nc, err := nats.Connect(srv.ClientURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer nc.Close()
+	speeds, err := nc.SubscribeSync("iracing.bmwm4gt3.speed")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := ioutil.ReadFile("testdata/session_basic.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Open(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.(SessionSink).WriteSessionInfo(string(b)); err != nil {
+		t.Fatal(err)
+	}
+	f := &Frame{TickCount: 1, Values: map[string]interface{}{"Speed": float32(10), "Lap": int32(2)}}
+	if err := sink.WriteFrame(f); err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Health(); err != nil {
+		t.Errorf("unexpected health %v", err)
+	}
+
+	msg, err := speeds.NextMsg(time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Frame
+	if err := json.Unmarshal(msg.Data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.TickCount != 1 || got.Values["Speed"] != float64(10) || len(got.Values) != 1 {
+		t.Errorf("unexpected speed frame %+v", got)
+	}
+
+	js, err := nc.JetStream()
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := js.StreamInfo("IRACING")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.State.Msgs != 3 {
+		t.Errorf("got %d stream messages want 2 frames and the session info", info.State.Msgs)
+	}
+	kv, err := js.KeyValue("iracing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry, err := kv.Get("session")
+	if err != nil || string(entry.Value()) != string(b) {
+		t.Errorf("unexpected session info in bucket %v", err)
+	}
+
+	if err := sink.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Health(); err == nil {
+		t.Error("expected unhealthy sink after close")
+	}
+}
+
+func TestSubjectTemplate(t *testing.T) {
+	st := newSubjectTemplate(subjectToken)
+	st.values["<car>"] = "mx5_mx52016"
+	if s := st.expand("iracing.<car>.<track>.<group>", "tyres.front"); s != "iracing.mx5_mx52016.unknown.tyres_front" {
+		t.Errorf("got %s", s)
+	}
+	if s := wildcardSubject("iracing.<car>.<group>"); s != "iracing.*.*" {
+		t.Errorf("got %s", s)
+	}
+}
+
+func TestNATSSinkSessionInfoAck(t *testing.T) {
+	srv := runNATSServer(t)
+	// no stream captures the subjects so JetStream publishes are not acked
+	sink, err := NewSink(&SinkConfig{Type: "nats", Options: map[string]interface{}{
+		"url":       srv.ClientURL(),
+		"jetstream": map[string]interface{}{"enabled": true, "acktimeout": "1s"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Open(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	defer sink.Close()
+	if err := sink.(SessionSink).WriteSessionInfo("---\n"); err != nil {
+		t.Fatal(err)
+	}
+	if err := sink.Flush(); err == nil {
+		t.Error("expected flush to report the session info was not acked")
+	}
+}
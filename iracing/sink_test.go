@@ -0,0 +1,146 @@
+package iracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memorySink records what it is written for tests
+type memorySink struct {
+	lock     sync.Mutex
+	openErr  error
+	frames   []*Frame
+	sessions []string
+	events   []*SessionEvent
+	closed   bool
+}
+
+func (s *memorySink) Open(ctx context.Context) error { return s.openErr }
+func (s *memorySink) Flush() error                   { return nil }
+func (s *memorySink) Health() error                  { return nil }
+
+func (s *memorySink) WriteFrame(f *Frame) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.frames = append(s.frames, f)
+	return nil
+}
+
+func (s *memorySink) WriteSessionInfo(sessionInfoYaml string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.sessions = append(s.sessions, sessionInfoYaml)
+	return nil
+}
+
+func (s *memorySink) WriteSessionEvent(e *SessionEvent) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.events = append(s.events, e)
+	return nil
+}
+
+func (s *memorySink) Close() error {
+	s.closed = true
+	return nil
+}
+
+// registerTestSink registers a sink type until the test ends, putting back any factory it replaces
+func registerTestSink(t *testing.T, sinkType string, factory SinkFactory) {
+	t.Helper()
+	sinkRegistry.Lock()
+	prev, ok := sinkRegistry.factories[sinkType]
+	sinkRegistry.Unlock()
+	RegisterSink(sinkType, factory)
+	t.Cleanup(func() {
+		sinkRegistry.Lock()
+		defer sinkRegistry.Unlock()
+		if ok {
+			sinkRegistry.factories[sinkType] = prev
+		} else {
+			delete(sinkRegistry.factories, sinkType)
+		}
+	})
+}
+
+func TestOutputSinks(t *testing.T) {
+	good := &memorySink{}
+	bad := &memorySink{openErr: errors.New("unreachable")}
+	registerTestSink(t, "test-good", func(cfg *SinkConfig) (Sink, error) { return good, nil })
+	registerTestSink(t, "test-bad", func(cfg *SinkConfig) (Sink, error) { return bad, nil })
+	path := filepath.Join(t.TempDir(), "frames.jsonl")
+
+	client := NewClient(&ClientConfig{Source: NewMemorySource(nil)})
+	o, err := newOutput(context.Background(), client, []SinkConfig{
+		{Type: "test-good", Vars: []string{"Speed"}, Units: "metric"},
+		{Type: "test-bad", Name: "broken"},
+		{Type: "file", Vars: []string{"Lap"}, Options: map[string]interface{}{"path": path}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	o.writeSessionInfo("---\n")
+	o.writeSessionEvent(&SessionEvent{Type: DriverJoined, CarIdx: 3})
+	for tick := 1; tick <= 3; tick++ {
+		client.publish(context.Background(), tick, 60, newTestSample(0, tick, 10))
+	}
+
+	select {
+	case e := <-client.SinkErrors():
+		if e.Sink != "broken" {
+			t.Errorf("unexpected sink error %v", e)
+		}
+	case <-time.After(time.Second):
+		t.Error("expected a sink error for the broken sink")
+	}
+	if err := o.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(good.frames) != 3 || good.frames[0].Values["Speed"] != float32(36) || len(good.sessions) != 1 || len(good.events) != 1 || !good.closed {
+		t.Errorf("unexpected good sink %+v", good)
+	}
+	status := o.Status()
+	if status[0].Health != nil || status[0].Metrics.Frames != 3 || status[0].Metrics.SessionWrites != 2 {
+		t.Errorf("unexpected good status %+v", status[0])
+	}
+	if status[1].Name != "broken" || status[1].Health == nil || status[1].Metrics.Errors == 0 || status[1].Metrics.Frames != 0 {
+		t.Errorf("unexpected broken status %+v", status[1])
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var types []string
+	for scanner := bufio.NewScanner(f); scanner.Scan(); {
+		var m WriterMessage
+		if err := json.Unmarshal(scanner.Bytes(), &m); err != nil {
+			t.Fatal(err)
+		}
+		types = append(types, m.Type)
+		if m.Type == "frame" && m.Frame.Values["Lap"] != float64(m.Frame.TickCount) {
+			t.Errorf("unexpected frame %+v", m.Frame)
+		}
+	}
+	if len(types) != 5 {
+		t.Errorf("got file messages %v", types)
+	}
+}
+
+func TestNewSinkUnknownType(t *testing.T) {
+	if _, err := NewSink(&SinkConfig{Type: "nope"}); err == nil {
+		t.Error("expected error for unknown sink type")
+	}
+	if _, err := NewSink(&SinkConfig{Type: "file"}); err == nil {
+		t.Error("expected error for file sink without a path")
+	}
+}
@@ -0,0 +1,125 @@
+package iracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+func init() {
+	RegisterSink("stdout", newStdoutSink)
+	RegisterSink("file", newFileSink)
+}
+
+// WriterMessage is a json line written by the stdout and file sinks and a message sent to websocket
+// clients. Type is frame, session or event, or error for websocket clients.
+type WriterMessage struct {
+	Type        string
+	Frame       *Frame        `json:",omitempty"`
+	SessionInfo string        `json:",omitempty"`
+	Event       *SessionEvent `json:",omitempty"`
+	Error       string        `json:",omitempty"`
+}
+
+// writerSink writes json lines to a writer
+type writerSink struct {
+	open func() (io.WriteCloser, error)
+	w    io.WriteCloser
+	bw   *bufio.Writer
+	enc  *json.Encoder
+
+	lock sync.Mutex
+	err  error // last write error
+}
+
+// FileSinkOptions are the options of the file sink
+type FileSinkOptions struct {
+	Path   string // path of the file to write
+	Append bool   // append to an existing file rather than truncating it
+}
+
+func newStdoutSink(cfg *SinkConfig) (Sink, error) {
+	return &writerSink{open: func() (io.WriteCloser, error) { return nopCloser{os.Stdout}, nil }}, nil
+}
+
+func newFileSink(cfg *SinkConfig) (Sink, error) {
+	var opts FileSinkOptions
+	if err := decodeSinkOptions(cfg, &opts); err != nil {
+		return nil, err
+	}
+	if opts.Path == "" {
+		return nil, fmt.Errorf("file sink needs a path")
+	}
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if opts.Append {
+		flags = os.O_WRONLY | os.O_CREATE | os.O_APPEND
+	}
+	return &writerSink{open: func() (io.WriteCloser, error) { return os.OpenFile(opts.Path, flags, 0644) }}, nil
+}
+
+func (s *writerSink) Open(ctx context.Context) error {
+	w, err := s.open()
+	if err != nil {
+		return err
+	}
+	s.w = w
+	s.bw = bufio.NewWriter(w)
+	s.enc = json.NewEncoder(s.bw)
+	return nil
+}
+
+func (s *writerSink) WriteFrame(f *Frame) error {
+	return s.encode(&WriterMessage{Type: "frame", Frame: f})
+}
+
+func (s *writerSink) WriteSessionInfo(sessionInfoYaml string) error {
+	return s.encode(&WriterMessage{Type: "session", SessionInfo: sessionInfoYaml})
+}
+
+func (s *writerSink) WriteSessionEvent(e *SessionEvent) error {
+	return s.encode(&WriterMessage{Type: "event", Event: e})
+}
+
+func (s *writerSink) encode(m *WriterMessage) error {
+	return s.setErr(s.enc.Encode(m))
+}
+
+func (s *writerSink) Flush() error {
+	return s.setErr(s.bw.Flush())
+}
+
+func (s *writerSink) setErr(err error) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.err = err
+	return err
+}
+
+func (s *writerSink) Close() error {
+	if s.w == nil {
+		return nil
+	}
+	err := s.bw.Flush()
+	if cerr := s.w.Close(); err == nil {
+		err = cerr
+	}
+	s.w = nil
+	return err
+}
+
+// Health returns the last write error
+func (s *writerSink) Health() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.err
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error { return nil }
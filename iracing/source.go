@@ -0,0 +1,53 @@
+package iracing
+
+import (
+	"errors"
+)
+
+// irsdkMemMapFileSize is the size of the iracing memory mapped file as defined by the irsdk
+const irsdkMemMapFileSize = 1164 * 1024
+
+// ErrSourceUnavailable is returned by a Source when the data it reads is not available yet
+// e.g. iRacing is not running. The client will keep retrying to open a source that returns it.
+var ErrSourceUnavailable = errors.New("iracing data source unavailable")
+
+// ErrSourceUnsupported is returned when a Source cannot be used on the current platform.
+var ErrSourceUnsupported = errors.New("iracing data source not supported on this platform")
+
+// Source provides the raw bytes of an iracing telemetry memory area.
+// The client parses the header, variable headers and variable buffers from the bytes
+// a source exposes so the same parsing can run against the live sim, a file or a byte slice.
+type Source interface {
+	// Open attaches to the underlying data
+	Open() error
+	// Bytes returns the memory area. The slice is only valid between Open and Close
+	Bytes() []byte
+	// Close releases the underlying data
+	Close() error
+}
+
+// memorySource is a Source backed by a byte slice
+type memorySource struct {
+	b []byte
+}
+
+// NewMemorySource returns a Source that reads from the byte slice b.
+// Useful for tests and for replaying a captured copy of the iracing memory mapped file.
+func NewMemorySource(b []byte) Source {
+	return &memorySource{b: b}
+}
+
+func (m *memorySource) Open() error {
+	if len(m.b) < headerLength {
+		return errors.New("memory source too short to contain an iracing header")
+	}
+	return nil
+}
+
+func (m *memorySource) Bytes() []byte {
+	return m.b
+}
+
+func (m *memorySource) Close() error {
+	return nil
+}
@@ -0,0 +1,58 @@
+//go:build linux
+// +build linux
+
+package iracing
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// fileSource reads a copy of the iracing memory mapped file saved to disk.
+// On linux the file is memory mapped read only.
+type fileSource struct {
+	path string
+	mem  []byte
+}
+
+// NewFileSource returns a Source reading the file at path.
+func NewFileSource(path string) Source {
+	return &fileSource{path: path}
+}
+
+func (s *fileSource) Open() error {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if fi.Size() < headerLength {
+		return fmt.Errorf("file %s too short to contain an iracing header", s.path)
+	}
+
+	mem, err := syscall.Mmap(int(f.Fd()), 0, int(fi.Size()), syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return fmt.Errorf("mmap %s: %w", s.path, err)
+	}
+	s.mem = mem
+	return nil
+}
+
+func (s *fileSource) Bytes() []byte {
+	return s.mem
+}
+
+func (s *fileSource) Close() error {
+	if s.mem == nil {
+		return nil
+	}
+	err := syscall.Munmap(s.mem)
+	s.mem = nil
+	return err
+}
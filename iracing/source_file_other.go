@@ -0,0 +1,42 @@
+//go:build !linux
+// +build !linux
+
+package iracing
+
+import (
+	"fmt"
+	"io/ioutil"
+)
+
+// fileSource reads a copy of the iracing memory mapped file saved to disk.
+// Outside of linux the file is read into memory.
+type fileSource struct {
+	path string
+	mem  []byte
+}
+
+// NewFileSource returns a Source reading the file at path.
+func NewFileSource(path string) Source {
+	return &fileSource{path: path}
+}
+
+func (s *fileSource) Open() error {
+	mem, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return err
+	}
+	if len(mem) < headerLength {
+		return fmt.Errorf("file %s too short to contain an iracing header", s.path)
+	}
+	s.mem = mem
+	return nil
+}
+
+func (s *fileSource) Bytes() []byte {
+	return s.mem
+}
+
+func (s *fileSource) Close() error {
+	s.mem = nil
+	return nil
+}
@@ -0,0 +1,25 @@
+//go:build !windows
+// +build !windows
+
+package iracing
+
+// liveSource is a placeholder for platforms without the iracing memory mapped file
+type liveSource struct{}
+
+// NewLiveSource returns a Source for the memory mapped file of a running iRacing sim.
+// iRacing only runs on windows, on other platforms Open returns ErrSourceUnsupported.
+func NewLiveSource() Source {
+	return &liveSource{}
+}
+
+func (s *liveSource) Open() error {
+	return ErrSourceUnsupported
+}
+
+func (s *liveSource) Bytes() []byte {
+	return nil
+}
+
+func (s *liveSource) Close() error {
+	return nil
+}
@@ -0,0 +1,112 @@
+package iracing
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+)
+
+// testVar describes a float variable written into a test memory area
+type testVar struct {
+	name  string
+	value float32
+}
+
+// newTestMemory lays out an iracing memory area with session yaml, float variable headers
+// and three variable buffers. The buffer at index newest has the highest tick count and
+// holds the values of vars, the other buffers hold zeros.
+func newTestMemory(session string, vars []testVar, newest int) []byte {
+	const numBuf = 3
+	const sessionOffset = 112
+	varHeaderOffset := sessionOffset + len(session) + 1
+	bufLen := 4 * len(vars)
+	bufOffset := varHeaderOffset + varHeaderLenth*len(vars)
+
+	b := make([]byte, bufOffset+numBuf*bufLen)
+	le := binary.LittleEndian
+	le.PutUint32(b[0:4], 2)   // ver
+	le.PutUint32(b[4:8], 1)   // status connected
+	le.PutUint32(b[8:12], 60) // tick rate
+	le.PutUint32(b[12:16], 1)
+	le.PutUint32(b[16:20], uint32(len(session)))
+	le.PutUint32(b[20:24], sessionOffset)
+	le.PutUint32(b[24:28], uint32(len(vars)))
+	le.PutUint32(b[28:32], uint32(varHeaderOffset))
+	le.PutUint32(b[32:36], numBuf)
+	le.PutUint32(b[36:40], uint32(bufLen))
+	for i := 0; i < numBuf; i++ {
+		s := bufInfoOffset + i*bufInfoLength
+		tick := 10 + i
+		if i == newest {
+			tick = 100
+		}
+		le.PutUint32(b[s:s+4], uint32(tick))
+		le.PutUint32(b[s+4:s+8], uint32(bufOffset+i*bufLen))
+	}
+	copy(b[sessionOffset:], session)
+
+	for i, v := range vars {
+		h := b[varHeaderOffset+i*varHeaderLenth:]
+		le.PutUint32(h[0:4], uint32(irfloat))
+		le.PutUint32(h[4:8], uint32(i*4))
+		le.PutUint32(h[8:12], 1)
+		copy(h[16:48], v.name)
+		copy(h[112:144], "m")
+		le.PutUint32(b[bufOffset+newest*bufLen+i*4:], math.Float32bits(v.value))
+	}
+	return b
+}
+
+// newTestClient returns a client that has read the test memory of session and vars, it is closed
+// when the test ends
+func newTestClient(t *testing.T, session string, vars []testVar) *Client {
+	t.Helper()
+	client := NewClient(&ClientConfig{Source: NewMemorySource(newTestMemory(session, vars, 1))})
+	if err := client.open(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(client.close)
+	if update, err := client.WaitForData(10 * time.Millisecond); err != nil || update == nil {
+		t.Fatalf("WaitForData got %v %v", update, err)
+	}
+	return client
+}
+
+func TestMemorySourceClient(t *testing.T) {
+	session := "---\nWeekendInfo:\n TrackName: spa\n"
+	vars := []testVar{{"LFshockDef", 0.25}, {"RFshockDef", -0.5}}
+	client := NewClient(&ClientConfig{Source: NewMemorySource(newTestMemory(session, vars, 1))})
+
+	if err := client.open(); err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer client.close()
+	if err := client.readHeader(); err != nil {
+		t.Fatalf("readHeader: %v", err)
+	}
+	if client.SessionInfoYaml != session {
+		t.Errorf("session info %q want %q", client.SessionInfoYaml, session)
+	}
+	if err := client.readVarHeaders(); err != nil {
+		t.Fatalf("readVarHeaders: %v", err)
+	}
+	if len(client.varHeaders) != len(vars) {
+		t.Fatalf("got %d variable headers want %d", len(client.varHeaders), len(vars))
+	}
+	if err := client.readVarBuf(); err != nil {
+		t.Fatalf("readVarBuf: %v", err)
+	}
+	for _, v := range vars {
+		if got, err := client.Float(v.name); err != nil || got != v.value {
+			t.Errorf("%s got %v %v want %v", v.name, got, err, v.value)
+		}
+	}
+}
+
+func TestMemorySourceTooShort(t *testing.T) {
+	client := NewClient(&ClientConfig{Source: NewMemorySource(make([]byte, 8))})
+	if err := client.open(); err == nil {
+		t.Error("expected error opening short memory source")
+	}
+}
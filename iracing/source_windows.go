@@ -0,0 +1,85 @@
+//go:build windows
+// +build windows
+
+package iracing
+
+import (
+	"fmt"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+const iracingMemoryMappedFileName string = "Local\\IRSDKMemMapFileName"
+
+// liveSource reads the iracing memory mapped file shared by a running sim
+type liveSource struct {
+	handle windows.Handle
+	addr   uintptr
+	mem    Mmap
+}
+
+// NewLiveSource returns a Source for the memory mapped file of a running iRacing sim.
+func NewLiveSource() Source {
+	return &liveSource{}
+}
+
+// Use was taken from syscall package:
+// Use is a no-op, but the compiler cannot see that it is.
+// Calling Use(p) ensures that p is kept live until that point.
+func (s *liveSource) use(unsafe.Pointer) {}
+
+// Open opens the iracing file and maps a view of it. An iracing file only exists if
+// iRacing is actually running, if it is not ErrSourceUnavailable is returned.
+func (s *liveSource) Open() error {
+	ptrName, err := windows.UTF16PtrFromString(iracingMemoryMappedFileName)
+	if err != nil {
+		return err
+	}
+	uPtrName := unsafe.Pointer(ptrName)
+
+	// open the file and get a ptr
+	modkernel32 := windows.NewLazyDLL("kernel32.dll")
+	procOpenFileMapping := modkernel32.NewProc("OpenFileMappingW")
+	winHandle, _, err := procOpenFileMapping.Call(uintptr(windows.FILE_MAP_READ), uintptr(0), uintptr(uPtrName))
+	s.use(uPtrName) // see use, if wierd stuff happens may have to look at winHandle too
+	if winHandle == 0 {
+		return fmt.Errorf("%w: opening %s: %v", ErrSourceUnavailable, iracingMemoryMappedFileName, err)
+	}
+
+	addr, err := windows.MapViewOfFile(windows.Handle(winHandle), uint32(windows.FILE_MAP_READ), 0, 0, 0)
+	if err != nil {
+		windows.CloseHandle(windows.Handle(winHandle))
+		return fmt.Errorf("creating map view of %s: %w", iracingMemoryMappedFileName, err)
+	}
+	s.handle = windows.Handle(winHandle)
+	s.addr = addr
+
+	// setup a slice around the mapped view
+	h := s.mem.Header()
+	h.Data = addr
+	h.Cap = irsdkMemMapFileSize
+	h.Len = irsdkMemMapFileSize
+	return nil
+}
+
+func (s *liveSource) Bytes() []byte {
+	return s.mem
+}
+
+func (s *liveSource) Close() error {
+	s.mem = nil
+	if s.addr != 0 {
+		if err := windows.UnmapViewOfFile(s.addr); err != nil {
+			return err
+		}
+		s.addr = 0
+	}
+	if s.handle != 0 {
+		if err := windows.CloseHandle(s.handle); err != nil {
+			return err
+		}
+		s.handle = 0
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package iracing
+
+import "time"
+
+// Status is a snapshot of what the client is doing
+type Status struct {
+	State      ConnectionState
+	TickCount  int       // tick count of the most recently read variable buffer
+	TickRate   int       // ticks per second written by the sim
+	LastTick   time.Time // time the most recently read variable buffer was read, zero if none has been
+	SessionNum int       // session of the most recently read sample
+	Sinks      []SinkStatus
+	Recordings []Recording
+}
+
+// Status returns the connection state, sinks and recordings of the client.
+// It may be called while Run is running.
+func (ir *Client) Status() *Status {
+	s := &Status{
+		State:      ir.State(),
+		Sinks:      ir.SinkStatus(),
+		Recordings: ir.Recordings(),
+	}
+	ir.varBufLock.Lock()
+	s.TickCount, s.TickRate, s.LastTick = ir.varBufTickCount, ir.varBufTickRate, ir.varBufTime
+	ir.varBufLock.Unlock()
+	if num, err := ir.Int("SessionNum"); err == nil {
+		s.SessionNum = int(num)
+	}
+	return s
+}
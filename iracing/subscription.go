@@ -0,0 +1,280 @@
+package iracing
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+)
+
+// Frame carries the values of the variables selected by a subscription for one tick
+type Frame struct {
+	TickCount   int
+	SessionTime float64
+	Values      map[string]interface{} // variable values by name, variables that can not be read are left out
+	Units       map[string]Unit        // units of the values by name, variables without a unit are left out
+}
+
+// SessionMessage is a session info update or a session event delivered on Subscription.Session
+type SessionMessage struct {
+	SessionInfo string        // session info yaml each time the sim updates it, empty for events
+	Event       *SessionEvent // change found in the session info, nil for session info updates
+}
+
+// Backpressure selects what a subscription does when its consumer is slower than the sim
+type Backpressure int
+
+const (
+	// DropOldest discards the oldest buffered frame to make room for the newest
+	DropOldest Backpressure = iota
+	// Block waits for the consumer, holding back the frames of later ticks for every subscription
+	Block
+)
+
+// SubscribeOptions configures a subscription
+type SubscribeOptions struct {
+	Rate         float64 // maximum frames per second, every tick if 0
+	Buffer       int     // frames buffered for the consumer, defaults to 1
+	Backpressure Backpressure
+	Units        DisplayUnits // converts values to display units e.g. ImperialUnits, irsdk units if nil
+	Session      bool         // also deliver session info updates and session events on Session
+	SessionOnly  bool         // only deliver session info updates and session events on Session, no frames on C
+}
+
+// Subscription delivers frames of selected variables on C until it is unsubscribed
+// or the client stops running.
+type Subscription struct {
+	dropped uint64 // frames and session messages dropped for a slow consumer, first for 64 bit alignment of atomic access
+
+	C <-chan *Frame
+	// Session receives session info updates and session events when subscribed with
+	// SubscribeOptions.Session, it is nil otherwise. Messages are dropped if it is not read and its
+	// buffer fills.
+	Session <-chan SessionMessage
+
+	c        chan *Frame
+	sendLock sync.Mutex // held while sending on c so Unsubscribe does not close it during a send
+	session  chan SessionMessage
+	done     chan struct{}
+	once     sync.Once
+	client   *Client
+	vars     []string
+	opts     SubscribeOptions
+	lastTick int
+	sent     bool // a frame has been sent so lastTick is set
+}
+
+// Subscribe returns a subscription to frames of the variables named in vars, or of every variable
+// if vars is empty. Every subscription is fed from the single reader started by Run so frames are only
+// delivered while Run is running. Variables missing from the sim are left out of frames.
+func (ir *Client) Subscribe(vars []string, opts *SubscribeOptions) *Subscription {
+	s := &Subscription{
+		done:   make(chan struct{}),
+		client: ir,
+		vars:   append([]string(nil), vars...),
+	}
+	if opts != nil {
+		s.opts = *opts
+	}
+	if s.opts.Buffer < 1 {
+		s.opts.Buffer = 1
+	}
+	s.c = make(chan *Frame, s.opts.Buffer)
+	s.C = s.c
+	if s.opts.Session || s.opts.SessionOnly {
+		s.session = make(chan SessionMessage, sessionEventBuffer)
+		s.Session = s.session
+	}
+
+	ir.subLock.Lock()
+	defer ir.subLock.Unlock()
+	ir.subscriptions = append(ir.subscriptions, s)
+	return s
+}
+
+// Unsubscribe stops delivery and closes C
+func (s *Subscription) Unsubscribe() {
+	s.once.Do(func() {
+		// done first so a blocked send gives up the send lock
+		close(s.done)
+		ir := s.client
+		ir.subLock.Lock()
+		for i, sub := range ir.subscriptions {
+			if sub == s {
+				ir.subscriptions = append(ir.subscriptions[:i], ir.subscriptions[i+1:]...)
+				break
+			}
+		}
+		if s.session != nil {
+			close(s.session)
+		}
+		ir.subLock.Unlock()
+		s.sendLock.Lock()
+		defer s.sendLock.Unlock()
+		close(s.c)
+	})
+}
+
+// SetVars changes the variables of the frames delivered from the next tick, every variable if vars is empty
+func (s *Subscription) SetVars(vars []string) {
+	ir := s.client
+	ir.subLock.Lock()
+	defer ir.subLock.Unlock()
+	s.vars = append([]string(nil), vars...)
+}
+
+// Vars returns the variables of the frames delivered, every variable if empty
+func (s *Subscription) Vars() []string {
+	ir := s.client
+	ir.subLock.Lock()
+	defer ir.subLock.Unlock()
+	return append([]string(nil), s.vars...)
+}
+
+// Dropped returns the number of frames and session messages dropped because the consumer did not keep up
+func (s *Subscription) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// Frame returns a frame of the latest values of the variables named in vars, or of every variable if
+// vars is empty. Returns an error if no variable buffer has been read.
+func (ir *Client) Frame(vars []string) (*Frame, error) {
+	sample, tickCount := ir.sampleTick()
+	if sample == nil {
+		return nil, fmt.Errorf("no variable buffer has been read")
+	}
+	s := &Subscription{vars: vars}
+	return s.frame(tickCount, sample), nil
+}
+
+// publish delivers a frame of sample to every subscription that is due one. Frames are sent without
+// the subscription lock so a Block consumer does not hold up Subscribe, SetVars and Vars, and to the
+// subscriptions dropping frames first so they do not wait for it.
+func (ir *Client) publish(ctx context.Context, tickCount, tickRate int, sample *Sample) {
+	ir.subLock.Lock()
+	var subs []*Subscription
+	var frames []*Frame
+	for _, s := range ir.subscriptions {
+		if s.opts.SessionOnly || !s.due(tickCount, tickRate) {
+			continue
+		}
+		s.lastTick = tickCount
+		s.sent = true
+		subs = append(subs, s)
+		frames = append(frames, s.frame(tickCount, sample))
+	}
+	ir.subLock.Unlock()
+
+	for i, s := range subs {
+		if s.opts.Backpressure != Block {
+			s.send(ctx, frames[i])
+		}
+	}
+	for i, s := range subs {
+		if s.opts.Backpressure == Block {
+			s.send(ctx, frames[i])
+		}
+	}
+}
+
+// publishSession delivers a session message to every subscription that asked for them
+func (ir *Client) publishSession(m SessionMessage) {
+	ir.subLock.Lock()
+	defer ir.subLock.Unlock()
+	for _, s := range ir.subscriptions {
+		if s.session == nil {
+			continue
+		}
+		select {
+		case s.session <- m:
+		default:
+			atomic.AddUint64(&s.dropped, 1)
+		}
+	}
+}
+
+// unsubscribeAll ends every subscription when the reader stops
+func (ir *Client) unsubscribeAll() {
+	ir.subLock.Lock()
+	subs := append([]*Subscription(nil), ir.subscriptions...)
+	ir.subLock.Unlock()
+	for _, s := range subs {
+		s.Unsubscribe()
+	}
+}
+
+// due reports whether a frame for tickCount should be sent under the subscription rate limit
+func (s *Subscription) due(tickCount, tickRate int) bool {
+	if s.opts.Rate <= 0 || !s.sent || tickCount < s.lastTick {
+		return true
+	}
+	if tickRate <= 0 {
+		tickRate = 60
+	}
+	minTicks := int(math.Round(float64(tickRate) / s.opts.Rate))
+	return tickCount-s.lastTick >= minTicks
+}
+
+func (s *Subscription) frame(tickCount int, sample *Sample) *Frame {
+	f := &Frame{
+		TickCount: tickCount,
+		Values:    make(map[string]interface{}, len(s.vars)),
+		Units:     make(map[string]Unit, len(s.vars)),
+	}
+	f.SessionTime, _ = sample.Double("SessionTime")
+	vars := s.vars
+	if len(vars) == 0 {
+		vars = make([]string, 0, len(sample.varHeaders))
+		for name := range sample.varHeaders {
+			vars = append(vars, name)
+		}
+	}
+	for _, name := range vars {
+		v, err := sample.Value(name)
+		if err != nil {
+			continue
+		}
+		u, _ := sample.Unit(name)
+		if s.opts.Units != nil {
+			v, u = s.opts.Units.ConvertValue(v, u)
+		}
+		f.Values[name] = v
+		if u != NoUnit {
+			f.Units[name] = u
+		}
+	}
+	return f
+}
+
+// send delivers f following the subscription backpressure unless the subscription has ended
+func (s *Subscription) send(ctx context.Context, f *Frame) {
+	s.sendLock.Lock()
+	defer s.sendLock.Unlock()
+	select {
+	case <-s.done:
+		return
+	default:
+	}
+	if s.opts.Backpressure == Block {
+		select {
+		case s.c <- f:
+		case <-s.done:
+		case <-ctx.Done():
+		}
+		return
+	}
+	for {
+		select {
+		case s.c <- f:
+			return
+		default:
+		}
+		// full, drop the oldest frame to make room
+		select {
+		case <-s.c:
+			atomic.AddUint64(&s.dropped, 1)
+		default:
+		}
+	}
+}
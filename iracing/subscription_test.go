@@ -0,0 +1,157 @@
+package iracing
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSubscriptionRateAndDropOldest(t *testing.T) {
+	client := NewClient(&ClientConfig{Source: NewMemorySource(nil)})
+	sub := client.Subscribe([]string{"Speed", "Nope"}, &SubscribeOptions{Rate: 20, Buffer: 2})
+	all := client.Subscribe([]string{"Lap"}, nil)
+
+	// 60Hz ticks at 20 frames per second delivers every third tick
+	for tick := 1; tick <= 10; tick++ {
+		client.publish(context.Background(), tick, 60, newTestSample(float64(tick)/60, tick, float32(tick)))
+	}
+
+	// the buffer of 2 holds the newest frames for ticks 7 and 10
+	for _, want := range []int{7, 10} {
+		f := <-sub.C
+		if f.TickCount != want || f.Values["Speed"] != float32(want) {
+			t.Errorf("got frame %+v want tick %d", f, want)
+		}
+		if _, ok := f.Values["Nope"]; ok {
+			t.Error("unknown variable in frame")
+		}
+	}
+	if f := <-all.C; f.TickCount != 10 || f.Values["Lap"] != int32(10) || f.SessionTime != 10.0/60 {
+		t.Errorf("unexpected frame %+v", f)
+	}
+
+	sub.Unsubscribe()
+	if _, ok := <-sub.C; ok {
+		t.Error("expected closed channel after unsubscribe")
+	}
+	client.unsubscribeAll()
+	if _, ok := <-all.C; ok {
+		t.Error("expected closed channel after unsubscribeAll")
+	}
+}
+
+// nextFrame receives the next frame of sub and fails the test if there is none in time
+func nextFrame(t *testing.T, sub *Subscription) *Frame {
+	t.Helper()
+	select {
+	case f := <-sub.C:
+		return f
+	case <-time.After(time.Second):
+		t.Fatal("no frame")
+	}
+	return nil
+}
+
+// within runs f and fails the test if it does not return in time
+func within(t *testing.T, name string, f func()) {
+	t.Helper()
+	done := make(chan struct{})
+	go func() {
+		f()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("%s blocked", name)
+	}
+}
+
+func TestSubscriptionBlock(t *testing.T) {
+	client := NewClient(&ClientConfig{Source: NewMemorySource(nil)})
+	block := client.Subscribe([]string{"Speed"}, &SubscribeOptions{Backpressure: Block})
+	drop := client.Subscribe([]string{"Speed"}, nil)
+
+	client.publish(context.Background(), 1, 60, newTestSample(0, 1, 1))
+	<-drop.C
+	// the block buffer is full so publishing tick 2 waits for the block consumer
+	published := make(chan struct{})
+	go func() {
+		client.publish(context.Background(), 2, 60, newTestSample(0, 1, 2))
+		close(published)
+	}()
+
+	// the drop oldest subscription is sent tick 2 while publish waits
+	if f := nextFrame(t, drop); f.TickCount != 2 {
+		t.Errorf("drop oldest got tick %d want 2", f.TickCount)
+	}
+	// and the subscriptions can be changed
+	within(t, "SetVars", func() { drop.SetVars([]string{"Lap"}) })
+	within(t, "Vars", func() { block.Vars() })
+	within(t, "Subscribe", func() { client.Subscribe(nil, nil) })
+	select {
+	case <-published:
+		t.Fatal("publish did not wait for the block consumer")
+	default:
+	}
+
+	for _, want := range []int{1, 2} {
+		if f := nextFrame(t, block); f.TickCount != want {
+			t.Errorf("block got tick %d want %d", f.TickCount, want)
+		}
+	}
+	within(t, "publish", func() { <-published })
+
+	// unsubscribing ends a waiting publish
+	client.publish(context.Background(), 3, 60, newTestSample(0, 1, 3))
+	published = make(chan struct{})
+	go func() {
+		client.publish(context.Background(), 4, 60, newTestSample(0, 1, 4))
+		close(published)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	within(t, "Unsubscribe", block.Unsubscribe)
+	within(t, "publish after unsubscribe", func() { <-published })
+	client.unsubscribeAll()
+}
+
+func TestSubscriptionsShareReader(t *testing.T) {
+	client := NewClient(&ClientConfig{Source: NewMemorySource(newTestMemory("---\n", []testVar{{"Speed", 42}, {"RPM", 6000}}, 1))})
+	speed := client.Subscribe([]string{"Speed"}, nil)
+	rpm := client.Subscribe([]string{"RPM"}, &SubscribeOptions{Backpressure: Block})
+	all := client.Subscribe(nil, &SubscribeOptions{Rate: 10, Buffer: 4})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error, 1)
+	go func() { done <- client.Run(ctx) }()
+
+	for _, c := range []struct {
+		sub  *Subscription
+		want map[string]interface{}
+	}{
+		{speed, map[string]interface{}{"Speed": float32(42)}},
+		{rpm, map[string]interface{}{"RPM": float32(6000)}},
+		{all, map[string]interface{}{"Speed": float32(42), "RPM": float32(6000)}},
+	} {
+		select {
+		case f := <-c.sub.C:
+			if f.TickCount != 100 || !reflect.DeepEqual(f.Values, c.want) {
+				t.Errorf("got frame %+v want %v at tick 100", f, c.want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no frame for %v", c.want)
+		}
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	for _, sub := range []*Subscription{speed, rpm, all} {
+		if _, ok := <-sub.C; ok {
+			t.Error("expected closed channel after Run returned")
+		}
+	}
+}
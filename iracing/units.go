@@ -0,0 +1,186 @@
+package iracing
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ErrUnitConversion is returned when there is no conversion between two units
+var ErrUnitConversion = errors.New("no unit conversion")
+
+// Unit is the unit of a variable parsed from its irsdk unit string
+type Unit string
+
+const (
+	NoUnit                 Unit = ""
+	MetersPerSecond        Unit = "m/s"
+	MetersPerSecondSquared Unit = "m/s^2"
+	Meters                 Unit = "m"
+	Radians                Unit = "rad"
+	RadiansPerSecond       Unit = "rad/s"
+	KiloPascals            Unit = "kPa"
+	Bar                    Unit = "bar"
+	Celsius                Unit = "C"
+	Percent                Unit = "%" // irsdk percentages are fractions from 0 to 1
+	RevsPerMinute          Unit = "revs/min"
+	Seconds                Unit = "s"
+	Litres                 Unit = "l"
+	LitresPerHour          Unit = "l/hr"
+	Kilograms              Unit = "kg"
+	KilogramsPerHour       Unit = "kg/h"
+	Volts                  Unit = "V"
+	NewtonMeters           Unit = "N*m"
+
+	// display units
+	KilometersPerHour Unit = "km/h"
+	MilesPerHour      Unit = "mph"
+	Degrees           Unit = "deg"
+	DegreesPerSecond  Unit = "deg/s"
+	PSI               Unit = "psi"
+	Fahrenheit        Unit = "F"
+	Gallons           Unit = "gal"
+	GallonsPerHour    Unit = "gal/hr"
+)
+
+// unitAliases are other spellings of units found in irsdk unit strings and configuration
+var unitAliases = map[string]Unit{
+	"m/s2":   MetersPerSecondSquared,
+	"L":      Litres,
+	"l/h":    LitresPerHour,
+	"rpm":    RevsPerMinute,
+	"kph":    KilometersPerHour,
+	"kmh":    KilometersPerHour,
+	"degC":   Celsius,
+	"degF":   Fahrenheit,
+	"gallon": Gallons,
+}
+
+// ParseUnit parses an irsdk unit string such as m/s or revs/min. Unit strings that are not
+// known are returned as they are, for example the irsdk_Flags unit of SessionFlags.
+func ParseUnit(s string) Unit {
+	s = strings.TrimSpace(s)
+	if u, ok := unitAliases[s]; ok {
+		return u
+	}
+	return Unit(s)
+}
+
+type unitPair struct {
+	from Unit
+	to   Unit
+}
+
+// conversions converts values between units
+var conversions = map[unitPair]func(float64) float64{
+	{MetersPerSecond, KilometersPerHour}: scale(3.6),
+	{MetersPerSecond, MilesPerHour}:      scale(3600 / 1609.344),
+	{Radians, Degrees}:                   scale(180 / math.Pi),
+	{RadiansPerSecond, DegreesPerSecond}: scale(180 / math.Pi),
+	{KiloPascals, PSI}:                   scale(1 / 6.894757293168),
+	{Bar, PSI}:                           scale(100 / 6.894757293168),
+	{Celsius, Fahrenheit}:                func(v float64) float64 { return v*9/5 + 32 },
+	{Litres, Gallons}:                    scale(1 / 3.785411784),
+	{LitresPerHour, GallonsPerHour}:      scale(1 / 3.785411784),
+}
+
+func scale(factor float64) func(float64) float64 {
+	return func(v float64) float64 { return v * factor }
+}
+
+// Convert returns v in u converted to the unit to
+func (u Unit) Convert(v float64, to Unit) (float64, error) {
+	if u == to {
+		return v, nil
+	}
+	conv, ok := conversions[unitPair{u, to}]
+	if !ok {
+		return 0, fmt.Errorf("%w from %s to %s", ErrUnitConversion, u, to)
+	}
+	return conv(v), nil
+}
+
+// DisplayUnits selects the unit values of each irsdk unit are converted to for display
+type DisplayUnits map[Unit]Unit
+
+// MetricUnits displays speeds in km/h and angles in degrees
+var MetricUnits = DisplayUnits{
+	MetersPerSecond:  KilometersPerHour,
+	Radians:          Degrees,
+	RadiansPerSecond: DegreesPerSecond,
+}
+
+// ImperialUnits displays speeds in mph, angles in degrees, pressures in psi, temperatures
+// in Fahrenheit and volumes in gallons
+var ImperialUnits = DisplayUnits{
+	MetersPerSecond:  MilesPerHour,
+	Radians:          Degrees,
+	RadiansPerSecond: DegreesPerSecond,
+	KiloPascals:      PSI,
+	Bar:              PSI,
+	Celsius:          Fahrenheit,
+	Litres:           Gallons,
+	LitresPerHour:    GallonsPerHour,
+}
+
+// ParseDisplayUnits returns the display units named metric or imperial. The empty name
+// returns nil which leaves values in their irsdk units.
+func ParseDisplayUnits(name string) (DisplayUnits, error) {
+	switch strings.ToLower(name) {
+	case "":
+		return nil, nil
+	case "metric":
+		return MetricUnits, nil
+	case "imperial":
+		return ImperialUnits, nil
+	}
+	return nil, fmt.Errorf("unknown display units %s, expected metric or imperial", name)
+}
+
+// ConvertValue converts a float32 or float64 value, or a slice of them, in unit u to its display unit.
+// Returns the value and unit unchanged if there is no display unit for u or the value is not a float.
+func (d DisplayUnits) ConvertValue(v interface{}, u Unit) (interface{}, Unit) {
+	to, ok := d[u]
+	if !ok {
+		return v, u
+	}
+	conv, ok := conversions[unitPair{u, to}]
+	if !ok {
+		return v, u
+	}
+	switch v := v.(type) {
+	case float32:
+		return float32(conv(float64(v))), to
+	case float64:
+		return conv(v), to
+	case []float32:
+		out := make([]float32, len(v))
+		for i := range v {
+			out[i] = float32(conv(float64(v[i])))
+		}
+		return out, to
+	case []float64:
+		out := make([]float64, len(v))
+		for i := range v {
+			out[i] = conv(v[i])
+		}
+		return out, to
+	}
+	return v, u
+}
+
+// Unit returns the unit of the variable named varName
+func (s *Sample) Unit(varName string) (Unit, error) {
+	vH := s.varHeaders[varName]
+	if vH == nil {
+		return NoUnit, fmt.Errorf("%w: %s", ErrUnknownVar, varName)
+	}
+	return ParseUnit(vH.unit), nil
+}
+
+// Unit returns the unit of the variable named varName
+func (ir *Client) Unit(varName string) (u Unit, err error) {
+	err = ir.withSample(func(s *Sample) error { u, err = s.Unit(varName); return err })
+	return u, err
+}
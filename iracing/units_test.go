@@ -0,0 +1,52 @@
+package iracing
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestUnitConvert(t *testing.T) {
+	tests := []struct {
+		from, to Unit
+		v, want  float64
+	}{
+		{MetersPerSecond, KilometersPerHour, 10, 36},
+		{MetersPerSecond, MilesPerHour, 44.704, 100},
+		{Radians, Degrees, math.Pi, 180},
+		{KiloPascals, PSI, 206.8427, 30},
+		{Celsius, Fahrenheit, 100, 212},
+		{Litres, Gallons, 3.785411784, 1},
+		{Seconds, Seconds, 5, 5},
+	}
+	for _, tc := range tests {
+		got, err := tc.from.Convert(tc.v, tc.to)
+		if err != nil || math.Abs(got-tc.want) > 1e-4 {
+			t.Errorf("%v %s to %s got %v %v want %v", tc.v, tc.from, tc.to, got, err, tc.want)
+		}
+	}
+	if _, err := Meters.Convert(1, Gallons); !errors.Is(err, ErrUnitConversion) {
+		t.Errorf("expected ErrUnitConversion got %v", err)
+	}
+	if u := ParseUnit(" rpm"); u != RevsPerMinute {
+		t.Errorf("got unit %q", u)
+	}
+}
+
+func TestSubscriptionDisplayUnits(t *testing.T) {
+	client := NewClient(&ClientConfig{Source: NewMemorySource(nil)})
+	sub := client.Subscribe([]string{"Speed", "ShockDefl", "Lap"}, &SubscribeOptions{Units: MetricUnits})
+	client.publish(context.Background(), 1, 60, newTestSample(0, 1, 10))
+
+	f := <-sub.C
+	if f.Values["Speed"] != float32(36) || f.Units["Speed"] != KilometersPerHour {
+		t.Errorf("unexpected speed %v %s", f.Values["Speed"], f.Units["Speed"])
+	}
+	if f.Units["ShockDefl"] != Meters {
+		t.Errorf("unexpected shock unit %s", f.Units["ShockDefl"])
+	}
+	if _, ok := f.Units["Lap"]; ok {
+		t.Error("expected no unit for Lap")
+	}
+}
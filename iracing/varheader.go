@@ -1,9 +1,9 @@
 package iracing
 
 import (
+	"bytes"
 	"encoding/binary"
-
-	"golang.org/x/sys/windows"
+	"fmt"
 )
 
 const varHeaderLenth int = 144
@@ -34,6 +34,14 @@ const (
 	irdoubleLen   varTypeLength = 8
 )
 
+// size returns the number of bytes a single value of the type uses, 0 for unknown types
+func (t varType) size() int {
+	if !t.valid() {
+		return 0
+	}
+	return [...]int{int(ircharLen), int(irboolLen), int(irintLen), int(irbitFieldLen), int(irfloatLen), int(irdoubleLen)}[t]
+}
+
 func (w varTypeLength) EnumIndex() int {
 	return int(w)
 }
@@ -55,9 +63,17 @@ const (
 )
 
 func (t varType) String() string {
+	if !t.valid() {
+		return fmt.Sprintf("unknown(%d)", int(t))
+	}
 	return [...]string{"char", "bool", "int", "bitField", "float", "double"}[t]
 }
 
+// valid reports whether t is one of the irsdk variable types
+func (t varType) valid() bool {
+	return t >= irchar && t <= irdouble
+}
+
 func (w varType) EnumIndex() int {
 	return int(w)
 }
@@ -70,9 +86,47 @@ func newVarHeader(b []byte) *varHeader {
 		count:       int(binary.LittleEndian.Uint32(b[8:12])),
 		countAsTime: b[12] != 0,
 		// pad         [3]byte there is padding in the iracing record but we will ignore
-		name: windows.ByteSliceToString(b[16:48]),
-		desc: windows.ByteSliceToString(b[48:112]),
-		unit: windows.ByteSliceToString(b[112:144]),
+		name: nulTerminatedString(b[16:48]),
+		desc: nulTerminatedString(b[48:112]),
+		unit: nulTerminatedString(b[112:144]),
 	}
 	return h
 }
+
+// bytes encodes the header in the iracing variable header layout read by newVarHeader
+func (h *varHeader) bytes() []byte {
+	b := make([]byte, varHeaderLenth)
+	binary.LittleEndian.PutUint32(b[0:4], uint32(h.t))
+	binary.LittleEndian.PutUint32(b[4:8], uint32(h.offset))
+	binary.LittleEndian.PutUint32(b[8:12], uint32(h.count))
+	if h.countAsTime {
+		b[12] = 1
+	}
+	copy(b[16:47], h.name) // leave room for the nul terminators
+	copy(b[48:111], h.desc)
+	copy(b[112:143], h.unit)
+	return b
+}
+
+// length returns the number of bytes the variable uses in a variable buffer
+func (h *varHeader) length() int {
+	return h.t.size() * h.count
+}
+
+// parseVarHeaders reads numVars variable headers from b and maps them by name
+func parseVarHeaders(b []byte, numVars int) map[string]*varHeader {
+	varHeaders := make(map[string]*varHeader, numVars)
+	for i := 0; i < numVars; i++ {
+		h := newVarHeader(b[i*varHeaderLenth : (i+1)*varHeaderLenth])
+		varHeaders[h.name] = h
+	}
+	return varHeaders
+}
+
+// nulTerminatedString returns the string in b up to the first nul byte, or all of b if there is none
+func nulTerminatedString(b []byte) string {
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		b = b[:i]
+	}
+	return string(b)
+}
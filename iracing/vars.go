@@ -0,0 +1,74 @@
+package iracing
+
+import (
+	"fmt"
+	"sort"
+)
+
+// VarInfo describes a telemetry variable from its irsdk variable header
+type VarInfo struct {
+	Name        string
+	Desc        string
+	Unit        Unit
+	Type        string // irsdk type, char, bool, int, bitField, float or double
+	Count       int    // number of values, more than 1 for array variables
+	CountAsTime bool
+}
+
+// varInfos returns the description of each variable header in the order of the variable buffer
+func varInfos(headers map[string]*varHeader) []VarInfo {
+	hs := make([]*varHeader, 0, len(headers))
+	for _, h := range headers {
+		hs = append(hs, h)
+	}
+	sort.Slice(hs, func(i, j int) bool { return hs[i].offset < hs[j].offset })
+
+	vars := make([]VarInfo, len(hs))
+	for i, h := range hs {
+		vars[i] = VarInfo{
+			Name:        h.name,
+			Desc:        h.desc,
+			Unit:        ParseUnit(h.unit),
+			Type:        h.t.String(),
+			Count:       h.count,
+			CountAsTime: h.countAsTime,
+		}
+	}
+	return vars
+}
+
+// Vars describes the variables of the sample
+func (s *Sample) Vars() []VarInfo {
+	return varInfos(s.varHeaders)
+}
+
+// Vars describes the variables of the file
+func (ibt *IBTReader) Vars() []VarInfo {
+	return varInfos(ibt.varHeaders)
+}
+
+// Vars describes the variables of the most recently read variable headers.
+// Returns nil if no variable headers have been read.
+func (ir *Client) Vars() []VarInfo {
+	ir.varBufLock.Lock()
+	defer ir.varBufLock.Unlock()
+	if ir.varHeaders == nil {
+		return nil
+	}
+	return varInfos(ir.varHeaders)
+}
+
+// ReadVars connects to the sim once to read its variable headers
+func (ir *Client) ReadVars() ([]VarInfo, error) {
+	if err := ir.open(); err != nil {
+		return nil, fmt.Errorf("opening client: %w", err)
+	}
+	defer ir.close()
+	if err := ir.readHeader(); err != nil {
+		return nil, err
+	}
+	if err := ir.readVarHeaders(); err != nil {
+		return nil, err
+	}
+	return ir.Vars(), nil
+}
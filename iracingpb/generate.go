@@ -0,0 +1,5 @@
+// Package iracingpb is the protobuf schema and generated gRPC client and server of the goiracing
+// Telemetry service. The service is implemented by iracing.NewTelemetryService.
+package iracingpb
+
+//go:generate buf generate --template buf.gen.yaml
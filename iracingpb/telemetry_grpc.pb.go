@@ -0,0 +1,276 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: telemetry.proto
+
+package iracingpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TelemetryClient is the client API for Telemetry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TelemetryClient interface {
+	// GetSession returns the most recently read session info
+	GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*Session, error)
+	// ListVariables returns the telemetry variables of the sim
+	ListVariables(ctx context.Context, in *ListVariablesRequest, opts ...grpc.CallOption) (*ListVariablesResponse, error)
+	// Subscribe streams frames of the requested variables until the call is cancelled
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Telemetry_SubscribeClient, error)
+	// StreamEvents streams session events, and optionally session info updates, until the call is cancelled
+	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (Telemetry_StreamEventsClient, error)
+}
+
+type telemetryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTelemetryClient(cc grpc.ClientConnInterface) TelemetryClient {
+	return &telemetryClient{cc}
+}
+
+func (c *telemetryClient) GetSession(ctx context.Context, in *GetSessionRequest, opts ...grpc.CallOption) (*Session, error) {
+	out := new(Session)
+	err := c.cc.Invoke(ctx, "/goiracing.v1.Telemetry/GetSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryClient) ListVariables(ctx context.Context, in *ListVariablesRequest, opts ...grpc.CallOption) (*ListVariablesResponse, error) {
+	out := new(ListVariablesResponse)
+	err := c.cc.Invoke(ctx, "/goiracing.v1.Telemetry/ListVariables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Telemetry_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Telemetry_ServiceDesc.Streams[0], "/goiracing.v1.Telemetry/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &telemetrySubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Telemetry_SubscribeClient interface {
+	Recv() (*Frame, error)
+	grpc.ClientStream
+}
+
+type telemetrySubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *telemetrySubscribeClient) Recv() (*Frame, error) {
+	m := new(Frame)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *telemetryClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (Telemetry_StreamEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Telemetry_ServiceDesc.Streams[1], "/goiracing.v1.Telemetry/StreamEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &telemetryStreamEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Telemetry_StreamEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type telemetryStreamEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *telemetryStreamEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TelemetryServer is the server API for Telemetry service.
+// All implementations must embed UnimplementedTelemetryServer
+// for forward compatibility
+type TelemetryServer interface {
+	// GetSession returns the most recently read session info
+	GetSession(context.Context, *GetSessionRequest) (*Session, error)
+	// ListVariables returns the telemetry variables of the sim
+	ListVariables(context.Context, *ListVariablesRequest) (*ListVariablesResponse, error)
+	// Subscribe streams frames of the requested variables until the call is cancelled
+	Subscribe(*SubscribeRequest, Telemetry_SubscribeServer) error
+	// StreamEvents streams session events, and optionally session info updates, until the call is cancelled
+	StreamEvents(*StreamEventsRequest, Telemetry_StreamEventsServer) error
+	mustEmbedUnimplementedTelemetryServer()
+}
+
+// UnimplementedTelemetryServer must be embedded to have forward compatible implementations.
+type UnimplementedTelemetryServer struct {
+}
+
+func (UnimplementedTelemetryServer) GetSession(context.Context, *GetSessionRequest) (*Session, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSession not implemented")
+}
+func (UnimplementedTelemetryServer) ListVariables(context.Context, *ListVariablesRequest) (*ListVariablesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVariables not implemented")
+}
+func (UnimplementedTelemetryServer) Subscribe(*SubscribeRequest, Telemetry_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedTelemetryServer) StreamEvents(*StreamEventsRequest, Telemetry_StreamEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEvents not implemented")
+}
+func (UnimplementedTelemetryServer) mustEmbedUnimplementedTelemetryServer() {}
+
+// UnsafeTelemetryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TelemetryServer will
+// result in compilation errors.
+type UnsafeTelemetryServer interface {
+	mustEmbedUnimplementedTelemetryServer()
+}
+
+func RegisterTelemetryServer(s grpc.ServiceRegistrar, srv TelemetryServer) {
+	s.RegisterService(&Telemetry_ServiceDesc, srv)
+}
+
+func _Telemetry_GetSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryServer).GetSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goiracing.v1.Telemetry/GetSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServer).GetSession(ctx, req.(*GetSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Telemetry_ListVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVariablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryServer).ListVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goiracing.v1.Telemetry/ListVariables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServer).ListVariables(ctx, req.(*ListVariablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Telemetry_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TelemetryServer).Subscribe(m, &telemetrySubscribeServer{stream})
+}
+
+type Telemetry_SubscribeServer interface {
+	Send(*Frame) error
+	grpc.ServerStream
+}
+
+type telemetrySubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *telemetrySubscribeServer) Send(m *Frame) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Telemetry_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TelemetryServer).StreamEvents(m, &telemetryStreamEventsServer{stream})
+}
+
+type Telemetry_StreamEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type telemetryStreamEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *telemetryStreamEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Telemetry_ServiceDesc is the grpc.ServiceDesc for Telemetry service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Telemetry_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "goiracing.v1.Telemetry",
+	HandlerType: (*TelemetryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSession",
+			Handler:    _Telemetry_GetSession_Handler,
+		},
+		{
+			MethodName: "ListVariables",
+			Handler:    _Telemetry_ListVariables_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Telemetry_Subscribe_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _Telemetry_StreamEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "telemetry.proto",
+}